@@ -5,6 +5,7 @@ import (
 	"context"
 	_ "embed"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"net/http"
@@ -14,6 +15,7 @@ import (
 	stdruntime "runtime"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/wailsapp/wails/v2/pkg/runtime"
@@ -35,14 +37,49 @@ type App struct {
 	cacheService    *services.CacheService
 	config          *services.Config
 	historyManager  *services.HistoryManager
+	lyricsOffsets   *services.LyricsOffsetStore
+	matchStore      *services.MatchStore
+	sessions        *services.MatchSessionStore
+	checkpoints     *services.CheckpointStore
+	watches         *services.WatchStore
+	watcher         *services.WatchScheduler
+	conversions     map[string]context.CancelFunc
+	conversionsMu   sync.Mutex
 }
 
 func NewApp() *App {
 	cfg, _ := services.LoadConfig()
-	hm, _ := services.NewHistoryManager()
+	hm, err := services.NewHistoryManager()
+	if err != nil {
+		log.Printf("failed to open transfer history: %v", err)
+	} else if err := hm.MarkInterrupted(); err != nil {
+		log.Printf("failed to mark interrupted transfers: %v", err)
+	}
+	lyricsOffsets, err := services.NewLyricsOffsetStore()
+	if err != nil {
+		log.Printf("failed to load lyrics offsets: %v", err)
+	}
 	dabService := services.NewDABService(cfg)
+	matchStore, err := services.NewMatchStore()
+	if err != nil {
+		log.Printf("failed to load match cache: %v", err)
+	}
+	dabService.SetMatchStore(matchStore)
+	sessions, err := services.NewMatchSessionStore()
+	if err != nil {
+		log.Printf("failed to open match sessions: %v", err)
+	}
+	checkpoints, err := services.NewCheckpointStore()
+	if err != nil {
+		log.Printf("failed to open conversion checkpoints: %v", err)
+	}
+	dabService.SetCheckpointStore(checkpoints)
+	watches, err := services.NewWatchStore()
+	if err != nil {
+		log.Printf("failed to load watched playlists: %v", err)
+	}
 
-	return &App{
+	app := &App{
 		spotifyService:  services.NewSpotifyService(cfg),
 		dabService:      dabService,
 		youtubeService:  services.NewYouTubeService(),
@@ -50,7 +87,15 @@ func NewApp() *App {
 		cacheService:    services.NewCacheService(cfg, dabService),
 		config:          cfg,
 		historyManager:  hm,
+		lyricsOffsets:   lyricsOffsets,
+		matchStore:      matchStore,
+		sessions:        sessions,
+		checkpoints:     checkpoints,
+		watches:         watches,
+		conversions:     make(map[string]context.CancelFunc),
 	}
+	app.watcher = services.NewWatchScheduler(watches, app.runWatch)
+	return app
 }
 
 func (a *App) startup(ctx context.Context) {
@@ -58,6 +103,12 @@ func (a *App) startup(ctx context.Context) {
 
 	a.spotifyService.TryRestoreSession()
 
+	a.dabService.OnMirrorChange(func(m services.DABMirror) {
+		runtime.EventsEmit(a.ctx, "dab-mirror-changed", m)
+	})
+	go a.dabService.RunHealthProbe(ctx, 2*time.Minute)
+	go a.watcher.Run(ctx)
+
 	go func() {
 		http.HandleFunc("/stream", a.cacheService.GetStream)
 		http.HandleFunc("/image", a.cacheService.GetImage)
@@ -232,39 +283,470 @@ func (a *App) SaveGeneralSettings(fuzzyScale int, maxConcurrency int, maxCacheSi
 	return services.SaveConfig(a.config)
 }
 
+func (a *App) SetMatchDurationTolerance(seconds int) error {
+	if seconds <= 0 {
+		return fmt.Errorf("duration tolerance must be positive")
+	}
+	a.config.DurationTolerance = seconds
+	return services.SaveConfig(a.config)
+}
+
+func (a *App) GetVersionPolicy() services.VersionPolicy {
+	return services.VersionPolicyFromConfig(a.config)
+}
+
+func (a *App) SaveVersionPolicy(policy services.VersionPolicy) error {
+	if err := policy.Validate(); err != nil {
+		return err
+	}
+	a.config.PreferExplicit = policy.Explicit
+	a.config.PreferEdition = policy.Edition
+	a.config.PreferPerformance = policy.Performance
+	a.config.PreferQuality = policy.Quality
+	a.config.VersionTieMargin = &policy.TieMargin
+	return services.SaveConfig(a.config)
+}
+
+func (a *App) SetDABRateLimit(requestsPerSecond float64) error {
+	if requestsPerSecond <= 0 {
+		return fmt.Errorf("requests per second must be positive")
+	}
+	a.config.DABRequestsPerSecond = requestsPerSecond
+	a.dabService.Limiter().SetRate(requestsPerSecond)
+	return services.SaveConfig(a.config)
+}
+
+func (a *App) SaveTLSSettings(mode, caCertPath string, pinnedSPKI []string) error {
+	prev := *a.config
+	a.config.DABTLSMode = strings.TrimSpace(mode)
+	a.config.DABCACertPath = strings.TrimSpace(caCertPath)
+	a.config.DABPinnedSPKI = pinnedSPKI
+	if err := a.dabService.ReloadTLS(); err != nil {
+		a.config.DABTLSMode = prev.DABTLSMode
+		a.config.DABCACertPath = prev.DABCACertPath
+		a.config.DABPinnedSPKI = prev.DABPinnedSPKI
+		return err
+	}
+	return services.SaveConfig(a.config)
+}
+
 func (a *App) SetDABAPIBase(base string) error {
 	a.config.DABAPIBase = strings.TrimSpace(base)
-	a.config.DABAuthToken = ""
+	a.dabService.ClearAuthSession()
 	a.config.DABEmail = ""
 	a.config.DABPassword = ""
+	a.dabService.ReloadMirrors()
+	return services.SaveConfig(a.config)
+}
+
+func (a *App) SetDABAPIBases(bases []string) error {
+	cleaned := make([]string, 0, len(bases))
+	for _, b := range bases {
+		if b = strings.TrimSpace(b); b != "" {
+			cleaned = append(cleaned, b)
+		}
+	}
+	a.config.DABAPIBases = cleaned
+	a.dabService.ReloadMirrors()
+	go a.dabService.ProbeMirrors(context.Background())
 	return services.SaveConfig(a.config)
 }
 
+func (a *App) GetDABMirrors() []services.DABMirror {
+	return a.dabService.Mirrors()
+}
+
+func (a *App) GetActiveDABMirror() services.DABMirror {
+	return a.dabService.ActiveMirror()
+}
+
 func (a *App) GetConfig() *services.Config {
 	return a.config
 }
 
 func (a *App) DABLogin(email, password string) error {
-	token, err := a.dabService.Login(email, password)
-	if err != nil {
+	if _, err := a.dabService.Login(email, password); err != nil {
 		return err
 	}
-	a.config.DABAuthToken = token
 	a.config.DABEmail = email
 	a.config.DABPassword = password
 	return services.SaveConfig(a.config)
 }
 
-func (a *App) CreateDABLibrary(name, description string, tracks []services.TrackInfo) (*services.TransferStats, error) {
-	return a.dabService.CreateLibrary(name, description, tracks, func(msg string) {
+func (a *App) startConversion(id string) (string, context.Context) {
+	parent := a.ctx
+	if parent == nil {
+		parent = context.Background()
+	}
+	ctx, cancel := context.WithCancel(parent)
+	if id == "" {
+		id = fmt.Sprintf("conversion_%d", time.Now().UnixNano())
+	}
+
+	a.conversionsMu.Lock()
+	a.conversions[id] = cancel
+	a.conversionsMu.Unlock()
+	return id, ctx
+}
+
+func (a *App) finishConversion(id string) {
+	a.conversionsMu.Lock()
+	cancel, ok := a.conversions[id]
+	delete(a.conversions, id)
+	a.conversionsMu.Unlock()
+	if ok {
+		cancel()
+	}
+}
+
+func (a *App) CancelConversion(id string) error {
+	a.conversionsMu.Lock()
+	cancel, ok := a.conversions[id]
+	a.conversionsMu.Unlock()
+	if !ok {
+		return fmt.Errorf("conversion %s is not running", id)
+	}
+	cancel()
+	return nil
+}
+
+func (a *App) CreateDABLibrary(name, description string, tracks []services.TrackInfo, sourceURL, conversionID string) (*services.TransferStats, error) {
+	id, ctx := a.startConversion(conversionID)
+	defer a.finishConversion(id)
+
+	tracker, onProgress, onTrackStatus := a.startTransfer(name, sourceURL, tracks)
+	origin := services.TransferOrigin{HistoryID: tracker.ID(), SourceURL: sourceURL}
+	stats, err := a.dabService.CreateLibraryContext(ctx, name, description, tracks, origin, onProgress, onTrackStatus)
+	a.finishTransfer(tracker, stats, err)
+	return transferResult(stats, a.reportDABError(err))
+}
+
+func (a *App) startTransfer(name, sourceURL string, tracks []services.TrackInfo) (*services.TransferTracker, func(string), func(int, string, string)) {
+	record := services.TransferRecord{
+		PlaylistName: name,
+		SourceURL:    sourceURL,
+		TotalTracks:  len(tracks),
+	}
+	switch {
+	case a.youtubeService.IsYouTubeURL(sourceURL):
+		record.Source = "YouTube"
+	case sourceURL != "":
+		record.Source = "Spotify"
+	case len(tracks) > 0 && tracks[0].SpotifyID != "":
+		record.Source = "Spotify"
+	}
+	return a.trackTransfer(a.historyManager.StartTransfer(record))
+}
+
+func (a *App) trackTransfer(tracker *services.TransferTracker) (*services.TransferTracker, func(string), func(int, string, string)) {
+	runtime.EventsEmit(a.ctx, "history-updated", tracker.ID())
+
+	onProgress, emitStatus := a.conversionCallbacks()
+	onTrackStatus := func(index int, status string, errorMsg string) {
+		emitStatus(index, status, errorMsg)
+		tracker.TrackStatus(index, status, errorMsg)
+	}
+	return tracker, onProgress, onTrackStatus
+}
+
+func (a *App) resumeTransfer(cp *services.ConversionCheckpoint, sources []services.TrackInfo) (*services.TransferTracker, func(string), func(int, string, string)) {
+	if cp.HistoryID == "" || a.historyManager == nil {
+		return a.startTransfer(cp.Name, cp.SourceURL, sources)
+	}
+	if _, err := a.historyManager.GetRecord(cp.HistoryID); err != nil {
+		return a.startTransfer(cp.Name, cp.SourceURL, sources)
+	}
+	return a.trackTransfer(a.historyManager.ResumeTransfer(cp.HistoryID))
+}
+
+func (a *App) finishTransfer(tracker *services.TransferTracker, stats *services.TransferStats, err error) {
+	tracker.Finish(stats, err)
+	runtime.EventsEmit(a.ctx, "history-updated", tracker.ID())
+}
+
+// transferResult returns a cancelled run as a result rather than an error so
+// the frontend still receives the partial stats; Status tells the two apart.
+func transferResult(stats *services.TransferStats, err error) (*services.TransferStats, error) {
+	if stats == nil {
+		return nil, err
+	}
+	switch {
+	case errors.Is(err, context.Canceled):
+		stats.Status = services.TransferStatusCancelled
+		return stats, nil
+	case err == nil:
+		stats.Status = services.TransferStatusCompleted
+	default:
+		stats.Status = services.TransferStatusFailed
+	}
+	return stats, err
+}
+
+func (a *App) ResumeConversion(id, conversionID string) (*services.TransferStats, error) {
+	cp, err := a.checkpoints.Load(id)
+	if err != nil {
+		return nil, err
+	}
+	sources := make([]services.TrackInfo, len(cp.Tracks))
+	for i, t := range cp.Tracks {
+		sources[i] = t.Source
+	}
+
+	convID, ctx := a.startConversion(conversionID)
+	defer a.finishConversion(convID)
+
+	tracker, onProgress, onTrackStatus := a.resumeTransfer(cp, sources)
+	stats, err := a.dabService.ResumeConversionContext(ctx, id, onProgress, onTrackStatus)
+	a.finishTransfer(tracker, stats, err)
+	return transferResult(stats, a.reportDABError(err))
+}
+
+func (a *App) GetConversionCheckpoints() ([]services.CheckpointSummary, error) {
+	return a.checkpoints.List()
+}
+
+func (a *App) GetConversionCheckpoint(id string) (*services.ConversionCheckpoint, error) {
+	return a.checkpoints.Load(id)
+}
+
+func (a *App) DeleteConversionCheckpoint(id string) error {
+	return a.checkpoints.Delete(id)
+}
+
+func (a *App) SyncLibrary(url, libraryID string, removeMissing bool, conversionID string) (*services.SyncResult, error) {
+	convID, ctx := a.startConversion(conversionID)
+	defer a.finishConversion(convID)
+
+	onProgress, onTrackStatus := a.conversionCallbacks()
+	result, err := a.syncSource(ctx, url, libraryID, removeMissing, "sync", onProgress, onTrackStatus)
+	return result, a.reportDABError(err)
+}
+
+func (a *App) syncSource(ctx context.Context, url, libraryID string, removeMissing bool, mode string, onProgress func(string), onTrackStatus func(int, string, string)) (*services.SyncResult, error) {
+	started := time.Now()
+	record := services.TransferRecord{
+		ID:           services.NewTransferID(),
+		PlaylistName: url,
+		SourceURL:    url,
+		Status:       services.TransferStatusCompleted,
+		CreatedAt:    started.Format(time.RFC3339),
+		LibraryID:    libraryID,
+		Source:       "Spotify",
+		Mode:         mode,
+	}
+	if a.youtubeService.IsYouTubeURL(url) {
+		record.Source = "YouTube"
+	}
+
+	var result *services.SyncResult
+	playlist, err := a.GetSpotifyPlaylist(url)
+	if err == nil {
+		record.PlaylistName = playlist.Name
+		record.TotalTracks = len(playlist.Tracks)
+		result, err = a.dabService.SyncLibraryContext(ctx, libraryID, playlist.Tracks, services.SyncOptions{RemoveMissing: removeMissing}, onProgress, onTrackStatus)
+	}
+
+	record.CompletedAt = time.Now().Format(time.RFC3339)
+	record.Duration = int(time.Since(started).Seconds())
+	if result != nil {
+		record.MatchedTracks = result.Matched
+		record.AddedTracks = result.Added
+		record.FailedTracks = result.Failed
+		record.RemovedTracks = result.Removed
+		record.Tracks = result.Tracks
+	}
+	if err != nil {
+		record.Status = services.TransferStatusFailed
+		if ctx.Err() != nil {
+			record.Status = services.TransferStatusCancelled
+		}
+		record.ErrorMessage = err.Error()
+	}
+	if a.historyManager != nil {
+		if herr := a.historyManager.AddRecord(record); herr != nil {
+			log.Printf("failed to record sync: %v", herr)
+		}
+		runtime.EventsEmit(a.ctx, "history-updated", record.ID)
+	}
+	if result != nil && (result.Added > 0 || result.Removed > 0) {
+		a.cacheService.SetCachedAPI("lib_details_"+libraryID, nil, -1)
+		a.cacheService.SetCachedAPI("libraries", nil, -1)
+	}
+	return result, err
+}
+
+func (a *App) runWatch(ctx context.Context, w services.WatchedPlaylist) (*services.SyncResult, error) {
+	onProgress := func(msg string) {}
+	onTrackStatus := func(index int, status string, errorMsg string) {}
+	result, err := a.syncSource(ctx, w.SourceURL, w.LibraryID, w.RemoveMissing, "watch", onProgress, onTrackStatus)
+
+	payload := map[string]interface{}{
+		"watchId":   w.ID,
+		"name":      w.Name,
+		"libraryId": w.LibraryID,
+		"result":    result,
+		"error":     "",
+	}
+	if err != nil {
+		payload["error"] = err.Error()
+	}
+	runtime.EventsEmit(a.ctx, "watch-synced", payload)
+	if result != nil && result.Added > 0 {
+		runtime.EventsEmit(a.ctx, "watch-new-tracks", map[string]interface{}{
+			"watchId":   w.ID,
+			"name":      w.Name,
+			"libraryId": w.LibraryID,
+			"added":     result.Added,
+			"tracks":    result.AddedTracks,
+		})
+	}
+	return result, err
+}
+
+func (a *App) GetWatchedPlaylists() []services.WatchedPlaylist {
+	return a.watches.List()
+}
+
+func (a *App) SaveWatchedPlaylist(w services.WatchedPlaylist) (*services.WatchedPlaylist, error) {
+	saved, err := a.watches.Save(w)
+	if err != nil {
+		return nil, err
+	}
+	a.watcher.Wake()
+	return &saved, nil
+}
+
+func (a *App) DeleteWatchedPlaylist(id string) error {
+	if err := a.watches.Delete(id); err != nil {
+		return err
+	}
+	a.watcher.Wake()
+	return nil
+}
+
+func (a *App) RunWatchedPlaylist(id string) (*services.SyncResult, error) {
+	result, err := a.watcher.RunNow(a.ctx, id)
+	return result, a.reportDABError(err)
+}
+
+func (a *App) DryRunConversion(tracks []services.TrackInfo, conversionID string) (*services.DryRunReport, error) {
+	id, ctx := a.startConversion(conversionID)
+	defer a.finishConversion(id)
+
+	onProgress, onTrackStatus := a.conversionCallbacks()
+	return a.dabService.DryRunContext(ctx, tracks, onProgress, onTrackStatus), nil
+}
+
+func (a *App) conversionCallbacks() (func(string), func(int, string, string)) {
+	onProgress := func(msg string) {
 		runtime.EventsEmit(a.ctx, "conversion-log", msg)
-	}, func(index int, status string, errorMsg string) {
+	}
+	onTrackStatus := func(index int, status string, errorMsg string) {
 		runtime.EventsEmit(a.ctx, "track-status", map[string]interface{}{
 			"index":  index,
 			"status": status,
 			"error":  errorMsg,
 		})
-	})
+	}
+	return onProgress, onTrackStatus
+}
+
+func (a *App) StartMatchSession(name, description string, tracks []services.TrackInfo, sourceURL, conversionID string) (*services.MatchSession, error) {
+	id, ctx := a.startConversion(conversionID)
+	defer a.finishConversion(id)
+
+	onProgress, onTrackStatus := a.conversionCallbacks()
+	session, err := a.dabService.StartMatchSessionContext(ctx, name, description, tracks, onProgress, onTrackStatus)
+	if err != nil {
+		return nil, a.reportDABError(err)
+	}
+	session.SourceURL = sourceURL
+	if err := a.sessions.Save(session); err != nil {
+		return session, err
+	}
+	return session, nil
+}
+
+func (a *App) GetMatchSessions() ([]services.MatchSessionSummary, error) {
+	return a.sessions.List()
+}
+
+func (a *App) GetMatchSession(id string) (*services.MatchSession, error) {
+	return a.sessions.Load(id)
+}
+
+func (a *App) UpdateMatchSelection(id string, selection services.MatchSelection) (*services.MatchSession, error) {
+	session, err := a.sessions.Load(id)
+	if err != nil {
+		return nil, err
+	}
+	if err := session.Select(selection); err != nil {
+		return nil, err
+	}
+	if err := a.sessions.Save(session); err != nil {
+		return nil, err
+	}
+	return session, nil
+}
+
+func (a *App) CommitMatchSession(id string, selections []services.MatchSelection, conversionID string) (*services.TransferStats, error) {
+	session, err := a.sessions.Load(id)
+	if err != nil {
+		return nil, err
+	}
+	if session.Status == services.SessionStatusCommitted {
+		return session.Stats, fmt.Errorf("match session %s was already committed", id)
+	}
+
+	convID, ctx := a.startConversion(conversionID)
+	defer a.finishConversion(convID)
+
+	sources := make([]services.TrackInfo, len(session.Tracks))
+	for i, t := range session.Tracks {
+		sources[i] = t.Source
+	}
+	tracker, onProgress, onTrackStatus := a.commitTransfer(session, sources)
+	stats, err := a.dabService.CommitMatchSessionContext(ctx, session, selections, tracker.ID(), onProgress, onTrackStatus)
+	a.finishTransfer(tracker, stats, err)
+	if err == nil {
+		session.Status = services.SessionStatusCommitted
+	}
+	session.Stats = stats
+	if saveErr := a.sessions.Save(session); saveErr != nil {
+		log.Printf("failed to save match session %s: %v", id, saveErr)
+	}
+	return transferResult(stats, a.reportDABError(err))
+}
+
+// commitTransfer continues the history record of an earlier, unfinished
+// commit of the session so a re-commit does not log a second transfer.
+func (a *App) commitTransfer(session *services.MatchSession, sources []services.TrackInfo) (*services.TransferTracker, func(string), func(int, string, string)) {
+	if session.CheckpointID != "" {
+		if cp, err := a.checkpoints.Load(session.CheckpointID); err == nil {
+			return a.resumeTransfer(cp, sources)
+		}
+	}
+	return a.startTransfer(session.Name, session.SourceURL, sources)
+}
+
+func (a *App) DeleteMatchSession(id string) error {
+	return a.sessions.Delete(id)
+}
+
+func (a *App) GetMatchCache() []services.MatchEntry {
+	return a.matchStore.Entries()
+}
+
+func (a *App) ClearMatchCache() error {
+	return a.matchStore.Clear()
+}
+
+func (a *App) DeleteMatchCacheEntry(key string) error {
+	return a.matchStore.Delete(key)
+}
+
+func (a *App) CorrectMatchCacheEntry(key string, track services.DABTrack) error {
+	return a.matchStore.Correct(key, track)
 }
 
 func (a *App) AddToLibrary(libraryID string, track services.TrackInfo) error {
@@ -308,22 +790,26 @@ func (a *App) RemoveFromLibrary(libraryID, trackID string) error {
 
 func (a *App) CheckDABSession() bool {
 
-	if a.config.DABAuthToken != "" {
-		if a.dabService.VerifyToken(a.config.DABAuthToken) {
+	if token := a.dabService.AuthToken(); token != "" {
+		if a.dabService.VerifyToken(token) {
 			return true
 		}
 	}
 
-	if a.config.DABEmail != "" && a.config.DABPassword != "" {
-		token, err := a.dabService.Login(a.config.DABEmail, a.config.DABPassword)
-		if err == nil {
-			a.config.DABAuthToken = token
-			services.SaveConfig(a.config)
-			return true
-		}
+	if err := a.dabService.Reauthenticate(context.Background()); err != nil {
+		return false
 	}
+	return a.dabService.AuthToken() != ""
+}
 
-	return false
+func (a *App) reportDABError(err error) error {
+	switch {
+	case errors.Is(err, services.ErrDABUnauthorized):
+		runtime.EventsEmit(a.ctx, "dab-session-expired", err.Error())
+	case errors.Is(err, services.ErrDABCloudflare):
+		runtime.EventsEmit(a.ctx, "dab-blocked", err.Error())
+	}
+	return err
 }
 
 func (a *App) CheckSpotifySession() bool {
@@ -331,7 +817,7 @@ func (a *App) CheckSpotifySession() bool {
 }
 
 func (a *App) Logout() {
-	a.config.DABAuthToken = ""
+	a.dabService.ClearAuthSession()
 	a.config.DABEmail = ""
 	a.config.DABPassword = ""
 	a.cacheService.ClearAPICache()
@@ -346,6 +832,27 @@ func (a *App) RecordTransfer(record services.TransferRecord) error {
 	return a.historyManager.AddRecord(record)
 }
 
+func (a *App) RetryFailedTracks(recordID, conversionID string) (*services.TransferRecord, error) {
+	record, err := a.historyManager.GetRecord(recordID)
+	if err != nil {
+		return nil, err
+	}
+
+	convID, ctx := a.startConversion(conversionID)
+	defer a.finishConversion(convID)
+
+	onProgress, _ := a.conversionCallbacks()
+	onTrackStatus := func(index int, status string, errorMsg string) {}
+	err = a.dabService.RetryTransferContext(ctx, record, onProgress, onTrackStatus)
+	if saveErr := a.historyManager.ReplaceRecord(*record); saveErr != nil {
+		log.Printf("failed to update transfer record %s: %v", recordID, saveErr)
+	}
+	runtime.EventsEmit(a.ctx, "history-updated", record.ID)
+	a.cacheService.SetCachedAPI("lib_details_"+record.LibraryID, nil, -1)
+	a.cacheService.SetCachedAPI("libraries", nil, -1)
+	return record, a.reportDABError(err)
+}
+
 func (a *App) ClearTransferHistory() error {
 	return a.historyManager.ClearHistory()
 }
@@ -371,7 +878,13 @@ func (a *App) ClearDownloadHistory() error {
 }
 
 func (a *App) SearchDAB(query string) ([]services.DABTrack, error) {
-	return a.dabService.Search(query)
+	results, err := a.dabService.Search(query)
+	return results, a.reportDABError(err)
+}
+
+func (a *App) SearchAdvanced(query string, filters services.SearchFilters) (*services.SearchResult, error) {
+	result, err := a.dabService.SearchAdvancedContext(a.ctx, query, filters)
+	return result, a.reportDABError(err)
 }
 
 func (a *App) GetStreamURL(trackID interface{}) (string, error) {
@@ -401,7 +914,7 @@ func (a *App) GetFavorites() ([]services.DABTrack, error) {
 	if err == nil {
 		a.cacheService.SetCachedAPI("favorites", favs, 5*time.Minute)
 	}
-	return favs, err
+	return favs, a.reportDABError(err)
 }
 
 func (a *App) AddToFavorites(track services.DABTrack) error {
@@ -422,14 +935,33 @@ func (a *App) RemoveFromFavorites(trackID string) error {
 	return err
 }
 
-func (a *App) GetLyrics(artist, title string) (map[string]interface{}, error) {
-	return a.dabService.GetLyrics(artist, title)
+func (a *App) GetLyrics(artist, title string) (*services.Lyrics, error) {
+	lyrics, err := a.dabService.GetLyrics(artist, title)
+	if err != nil {
+		return nil, a.reportDABError(err)
+	}
+	return lyrics.WithOffset(a.lyricsOffsets.Get(artist, title)), nil
 }
 
-func (a *App) GetAlbumByID(albumID string) (map[string]interface{}, error) {
+func (a *App) SetLyricsOffset(artist, title string, offsetMs int) (*services.Lyrics, error) {
+	if err := a.lyricsOffsets.Set(artist, title, offsetMs); err != nil {
+		return nil, err
+	}
+	return a.GetLyrics(artist, title)
+}
+
+func (a *App) GetAlbumByID(albumID string) (*services.DABAlbum, error) {
 	return a.dabService.GetAlbumByID(albumID)
 }
 
+func (a *App) GetArtist(artistID string) (*services.DABArtist, error) {
+	return a.dabService.GetArtist(artistID)
+}
+
+func (a *App) GetArtistDiscography(artistID string) ([]services.DABAlbum, error) {
+	return a.dabService.GetArtistDiscography(artistID)
+}
+
 func (a *App) DeleteLibrary(libraryID string) error {
 	err := a.dabService.DeleteLibrary(libraryID)
 	if err == nil {
@@ -474,7 +1006,7 @@ func (a *App) GetLibraries() ([]services.Library, error) {
 	if err == nil {
 		a.cacheService.SetCachedAPI("libraries", libs, 5*time.Minute)
 	}
-	return libs, err
+	return libs, a.reportDABError(err)
 }
 
 func (a *App) RefreshLibraries() ([]services.Library, error) {
@@ -484,7 +1016,7 @@ func (a *App) RefreshLibraries() ([]services.Library, error) {
 	if err == nil {
 		a.cacheService.SetCachedAPI("libraries", libs, 5*time.Minute)
 	}
-	return libs, err
+	return libs, a.reportDABError(err)
 }
 
 func (a *App) GetLibraryDetails(id string) (*services.LibraryDetailsResponse, error) {
@@ -498,7 +1030,20 @@ func (a *App) GetLibraryDetails(id string) (*services.LibraryDetailsResponse, er
 	if err == nil {
 		a.cacheService.SetCachedAPI(cacheKey, details, 5*time.Minute)
 	}
-	return details, err
+	return details, a.reportDABError(err)
+}
+
+func (a *App) StreamLibraryTracks(libraryID string, pageSize int) (int, error) {
+	count := 0
+	_, err := a.dabService.IterateLibraryTracks(a.ctx, libraryID, pageSize, func(page services.TrackPage) error {
+		count += len(page.Tracks)
+		runtime.EventsEmit(a.ctx, "library-tracks-page", map[string]interface{}{
+			"libraryId": libraryID,
+			"page":      page,
+		})
+		return nil
+	})
+	return count, a.reportDABError(err)
 }
 
 func (a *App) GetAppVersion() string {
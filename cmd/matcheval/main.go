@@ -0,0 +1,40 @@
+package main
+
+import (
+	"0xDABmusic/services"
+	"flag"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+)
+
+func main() {
+	corpus := flag.String("corpus", "services/testdata/match_corpus.json", "path to the match corpus")
+	thresholds := flag.String("thresholds", "50,60,70,75,80,85,90,95", "comma separated FuzzyMatchScale values")
+	tolerance := flag.Int("tolerance", 3, "duration tolerance in seconds")
+	verbose := flag.Bool("v", false, "print failing cases for each threshold")
+	flag.Parse()
+
+	cases, err := services.LoadMatchCorpus(*corpus)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Printf("%d cases from %s\n\n", len(cases), *corpus)
+	fmt.Printf("%-9s %5s %5s %5s %5s %9s %7s\n", "threshold", "TP", "FP", "FN", "TN", "precision", "recall")
+	for _, raw := range strings.Split(*thresholds, ",") {
+		threshold, err := strconv.Atoi(strings.TrimSpace(raw))
+		if err != nil {
+			log.Fatalf("invalid threshold %q", raw)
+		}
+		cfg := &services.Config{FuzzyMatchScale: threshold, DurationTolerance: *tolerance}
+		eval := services.EvaluateMatcher(services.NewWeightedMatcher(cfg), threshold, cases)
+		fmt.Printf("%-9d %5d %5d %5d %5d %9.3f %7.3f\n", threshold, eval.TruePositives, eval.FalsePositives, eval.FalseNegatives, eval.TrueNegatives, eval.Precision, eval.Recall)
+		if *verbose {
+			for _, f := range eval.Failures {
+				fmt.Printf("    %s\n", f)
+			}
+		}
+	}
+}
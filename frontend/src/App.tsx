@@ -1,4 +1,4 @@
-import { useState, useEffect } from "react";
+import { useState, useEffect, useRef } from "react";
 import { Sidebar } from "@/components/Sidebar";
 import { DABLogin } from "@/pages/Login";
 import { SettingsPage } from "@/pages/Settings";
@@ -15,6 +15,7 @@ import { toast } from "sonner";
 import { FullPageLoader } from "@/components/Loader";
 import { ProcessingStatus } from "@/components/ProcessingStatus";
 import { useQueueStore } from "@/lib/queue-store";
+import { EventsOn } from "../wailsjs/runtime/runtime";
 import {
   Dialog,
   DialogContent,
@@ -39,13 +40,19 @@ declare global {
             maxConcurrency: number,
             maxCacheSize: number
           ) => Promise<void>;
+          SetMatchDurationTolerance: (seconds: number) => Promise<void>;
+          GetVersionPolicy: () => Promise<any>;
+          SaveVersionPolicy: (policy: any) => Promise<void>;
           GetConfig: () => Promise<any>;
           DABLogin: (email: string, pass: string) => Promise<string>;
           CreateDABLibrary: (
             name: string,
             desc: string,
-            tracks: any[]
+            tracks: any[],
+            sourceURL: string,
+            conversionID: string
           ) => Promise<any>;
+          CancelConversion: (id: string) => Promise<void>;
           AddToLibrary: (libraryID: string, track: any) => Promise<void>;
           RecordTransfer: (record: any) => Promise<void>;
           GetTransferHistory: () => Promise<any[]>;
@@ -57,6 +64,7 @@ declare global {
           DownloadTrack: (track: any) => Promise<string>;
           GetDownloadQueue: () => Promise<any[]>;
           SearchDAB: (query: string) => Promise<any[]>;
+          SearchAdvanced: (query: string, filters: any) => Promise<any>;
           GetStreamURL: (trackID: string) => Promise<string>;
           GetFavorites: () => Promise<any[]>;
           AddToFavorites: (track: any) => Promise<void>;
@@ -76,7 +84,14 @@ declare global {
           OpenMusicFolder: () => Promise<void>;
           OpenConfigFolder: () => Promise<void>;
           GetLyrics: (artist: string, title: string) => Promise<any>;
+          SetLyricsOffset: (
+            artist: string,
+            title: string,
+            offsetMs: number
+          ) => Promise<any>;
           GetAlbumByID: (albumId: string) => Promise<any>;
+          GetArtist: (artistId: string) => Promise<any>;
+          GetArtistDiscography: (artistId: string) => Promise<any[]>;
           GetQueue: () => Promise<any[]>;
           SaveQueue: (queue: any[]) => Promise<void>;
           ClearQueue: () => Promise<void>;
@@ -89,6 +104,48 @@ declare global {
           DeleteLibrary: (libraryID: string) => Promise<void>;
           GetCurrentUser: () => Promise<any>;
           GetAppVersion: () => Promise<string>;
+          GetMatchCache: () => Promise<any[]>;
+          ClearMatchCache: () => Promise<void>;
+          DeleteMatchCacheEntry: (key: string) => Promise<void>;
+          CorrectMatchCacheEntry: (key: string, track: any) => Promise<void>;
+          StartMatchSession: (
+            name: string,
+            description: string,
+            tracks: any[],
+            sourceURL: string,
+            conversionID: string
+          ) => Promise<any>;
+          GetMatchSessions: () => Promise<any[]>;
+          GetMatchSession: (id: string) => Promise<any>;
+          UpdateMatchSelection: (id: string, selection: any) => Promise<any>;
+          CommitMatchSession: (
+            id: string,
+            selections: any[],
+            conversionID: string
+          ) => Promise<any>;
+          DeleteMatchSession: (id: string) => Promise<void>;
+          DryRunConversion: (
+            tracks: any[],
+            conversionID: string
+          ) => Promise<any>;
+          ResumeConversion: (id: string, conversionID: string) => Promise<any>;
+          GetConversionCheckpoints: () => Promise<any[]>;
+          GetConversionCheckpoint: (id: string) => Promise<any>;
+          DeleteConversionCheckpoint: (id: string) => Promise<void>;
+          SyncLibrary: (
+            url: string,
+            libraryID: string,
+            removeMissing: boolean,
+            conversionID: string
+          ) => Promise<any>;
+          GetWatchedPlaylists: () => Promise<any[]>;
+          SaveWatchedPlaylist: (watch: any) => Promise<any>;
+          DeleteWatchedPlaylist: (id: string) => Promise<void>;
+          RunWatchedPlaylist: (id: string) => Promise<any>;
+          RetryFailedTracks: (
+            recordID: string,
+            conversionID: string
+          ) => Promise<any>;
         };
       };
     };
@@ -101,6 +158,9 @@ function App() {
   const [loading, setLoading] = useState(true);
   const [history, setHistory] = useState<any[]>([]);
   const [showClearHistoryDialog, setShowClearHistoryDialog] = useState(false);
+  const [detailRecord, setDetailRecord] = useState<any>(null);
+  const [retrying, setRetrying] = useState(false);
+  const retryIdRef = useRef<string | null>(null);
   const [viewState, setViewState] = useState<{
     page: string;
     params: Record<string, string>;
@@ -142,6 +202,19 @@ function App() {
     loadHistory();
   }, [isLoggedIn]);
 
+  useEffect(() => {
+    const cancelNewTracks = EventsOn("watch-new-tracks", (data: any) => {
+      toast.success(`${data.added} new tracks synced into "${data.name}"`);
+    });
+    const cancelHistory = EventsOn("history-updated", () => {
+      loadHistory();
+    });
+    return () => {
+      cancelNewTracks();
+      cancelHistory();
+    };
+  }, []);
+
   const handleClearHistory = () => {
     setShowClearHistoryDialog(true);
   };
@@ -158,6 +231,48 @@ function App() {
     }
   };
 
+  const retryableTracks = (record: any) =>
+    (record?.tracks || []).filter((t: any) =>
+      ["failed", "not-found", "cancelled"].includes(t.status)
+    );
+
+  const handleRetryFailed = async (record: any) => {
+    const conversionId = `conversion_${Date.now()}`;
+    retryIdRef.current = conversionId;
+    setRetrying(true);
+    try {
+      const updated = await window.go.main.App.RetryFailedTracks(
+        record.id,
+        conversionId
+      );
+      const remaining = retryableTracks(updated).length;
+      toast.success(
+        remaining > 0
+          ? `Retry finished, ${remaining} tracks still missing`
+          : "All tracks transferred"
+      );
+      if (detailRecord?.id === updated.id) {
+        setDetailRecord(updated);
+      }
+    } catch (e: any) {
+      toast.error("Retry failed: " + e);
+    } finally {
+      retryIdRef.current = null;
+      setRetrying(false);
+      loadHistory();
+    }
+  };
+
+  const handleCancelRetry = async () => {
+    const id = retryIdRef.current;
+    if (!id) return;
+    try {
+      await window.go.main.App.CancelConversion(id);
+    } catch (e: any) {
+      toast.error("Failed to cancel: " + e);
+    }
+  };
+
   if (loading) {
     return <FullPageLoader message="Initializing 0xDABmusic..." />;
   }
@@ -224,6 +339,7 @@ function App() {
                             <th className="px-6 py-3 text-left font-medium">
                               Duration
                             </th>
+                            <th className="px-6 py-3 text-left font-medium" />
                           </tr>
                         </thead>
                         <tbody>
@@ -266,6 +382,12 @@ function App() {
                                 <span className="text-slate-500">
                                   /{record.totalTracks}
                                 </span>
+                                {record.removedTracks > 0 && (
+                                  <span className="text-red-400">
+                                    {" "}
+                                    −{record.removedTracks}
+                                  </span>
+                                )}
                               </td>
                               <td className="px-6 py-3">
                                 <span
@@ -288,6 +410,16 @@ function App() {
                               <td className="px-6 py-3 text-slate-400">
                                 {record.duration}s
                               </td>
+                              <td className="px-6 py-3">
+                                {record.tracks?.length > 0 && (
+                                  <button
+                                    onClick={() => setDetailRecord(record)}
+                                    className="text-blue-400 hover:underline text-xs"
+                                  >
+                                    Details
+                                  </button>
+                                )}
+                              </td>
                             </tr>
                           ))}
                         </tbody>
@@ -370,6 +502,63 @@ function App() {
         </DialogContent>
       </Dialog>
 
+      <Dialog
+        open={!!detailRecord}
+        onOpenChange={(open) => !open && setDetailRecord(null)}
+      >
+        <DialogContent className="max-w-2xl">
+          <DialogHeader>
+            <DialogTitle>{detailRecord?.playlistName}</DialogTitle>
+            <DialogDescription>
+              {detailRecord?.addedTracks}/{detailRecord?.totalTracks} tracks
+              added · {retryableTracks(detailRecord).length} failed or
+              unmatched
+            </DialogDescription>
+          </DialogHeader>
+          <div className="max-h-96 overflow-y-auto text-sm">
+            {(detailRecord?.tracks || []).map((t: any, i: number) => (
+              <div
+                key={i}
+                className="flex justify-between gap-4 border-b border-slate-800 py-1"
+              >
+                <div className="min-w-0">
+                  <p className="truncate">
+                    {t.source.artist} - {t.source.title}
+                  </p>
+                  {t.error && (
+                    <p className="truncate text-xs text-red-400">{t.error}</p>
+                  )}
+                </div>
+                <span className="text-xs text-slate-400 whitespace-nowrap">
+                  {t.status}
+                  {t.trackId ? ` · ${t.score}%` : ""}
+                </span>
+              </div>
+            ))}
+          </div>
+          <DialogFooter>
+            <Button variant="outline" onClick={() => setDetailRecord(null)}>
+              Close
+            </Button>
+            {retrying && (
+              <Button variant="outline" onClick={handleCancelRetry}>
+                Cancel
+              </Button>
+            )}
+            <Button
+              onClick={() => handleRetryFailed(detailRecord)}
+              disabled={
+                retrying ||
+                !detailRecord?.libraryID ||
+                retryableTracks(detailRecord).length === 0
+              }
+            >
+              {retrying ? "Retrying..." : "Retry Failed Tracks"}
+            </Button>
+          </DialogFooter>
+        </DialogContent>
+      </Dialog>
+
       <Toaster />
       <ProcessingStatus />
     </div>
@@ -2,6 +2,7 @@ import { useEffect, useState, useRef } from "react";
 import { useLyricsStore } from "@/lib/lyrics-store";
 import { ScrollArea } from "@/components/ui/scroll-area";
 import { InlineLoader } from "@/components/Loader";
+import { Button } from "@/components/ui/button";
 import { FileText } from "lucide-react";
 
 interface LyricsDisplayProps {
@@ -10,18 +11,21 @@ interface LyricsDisplayProps {
   currentTime?: number;
 }
 
-interface LyricLine {
-  time: number;
-  text: string;
-}
-
 export function LyricsDisplay({
   artist,
   title,
   currentTime = 0,
 }: LyricsDisplayProps) {
-  const { lyrics, isLoading, isUnsynced, fetchLyrics } = useLyricsStore();
-  const [parsedLyrics, setParsedLyrics] = useState<LyricLine[]>([]);
+  const {
+    lyrics,
+    lines,
+    isLoading,
+    isUnsynced,
+    offsetMs,
+    fetchLyrics,
+    setOffset,
+  } = useLyricsStore();
+  const parsedLyrics = isUnsynced ? [] : lines;
   const [currentLineIndex, setCurrentLineIndex] = useState(0);
   const scrollRef = useRef<HTMLDivElement>(null);
 
@@ -31,61 +35,14 @@ export function LyricsDisplay({
     }
   }, [artist, title, fetchLyrics]);
 
-  useEffect(() => {
-    if (!lyrics) {
-      setParsedLyrics([]);
-      return;
-    }
-
-    const hasLRCFormat = /\[\d{2}:\d{2}\.\d{2}\]/.test(lyrics);
-    console.log(
-      "[Lyrics] Has LRC timestamps:",
-      hasLRCFormat,
-      "API unsynced flag:",
-      isUnsynced
-    );
-
-    if (!hasLRCFormat) {
-      console.log("[Lyrics] Displaying unsynced lyrics (no timestamps found)");
-      setParsedLyrics([]);
-      return;
-    }
-
-    console.log("[Lyrics] Parsing as synced lyrics...");
-    console.log("[Lyrics] Raw lyrics:", lyrics.substring(0, 200));
-    const lines: LyricLine[] = [];
-    const lrcPattern = /\[(\d{2}):(\d{2})\.(\d{2})\](.+)/g;
-    let match;
-    while ((match = lrcPattern.exec(lyrics)) !== null) {
-      const minutes = parseInt(match[1]);
-      const seconds = parseInt(match[2]);
-      const centiseconds = parseInt(match[3]);
-      const time = minutes * 60 + seconds + centiseconds / 100;
-      const text = match[4].trim();
-      if (text) lines.push({ time, text });
-    }
-    console.log("[Lyrics] Parsed", lines.length, "lines");
-    if (lines.length > 0) {
-      console.log("[Lyrics] First few lines:", lines.slice(0, 3));
-    }
-    setParsedLyrics(lines);
-  }, [lyrics, isUnsynced]);
-
   useEffect(() => {
     if (parsedLyrics.length > 0 && currentTime > 0) {
-      let index = parsedLyrics.findIndex((line) => line.time > currentTime);
+      const currentMs = currentTime * 1000;
+      let index = parsedLyrics.findIndex((line) => line.timeMs > currentMs);
       index = index === -1 ? parsedLyrics.length - 1 : Math.max(0, index - 1);
-      if (index !== currentLineIndex) {
-        console.log(
-          "[Lyrics] Current time:",
-          currentTime,
-          "Line index:",
-          index
-        );
-      }
       setCurrentLineIndex(index);
     }
-  }, [currentTime, parsedLyrics, currentLineIndex]);
+  }, [currentTime, parsedLyrics]);
 
   useEffect(() => {
     if (scrollRef.current && parsedLyrics.length > 0) {
@@ -121,6 +78,33 @@ export function LyricsDisplay({
         <div className="text-center mb-6">
           <h3 className="text-xl font-bold">{title}</h3>
           <p className="text-muted-foreground">{artist}</p>
+          {parsedLyrics.length > 0 && (
+            <div className="flex items-center justify-center gap-2 mt-2 text-xs text-muted-foreground">
+              <Button
+                variant="ghost"
+                size="sm"
+                onClick={() => setOffset(offsetMs - 250)}
+              >
+                -0.25s
+              </Button>
+              <span>
+                Offset {offsetMs > 0 ? "+" : ""}
+                {(offsetMs / 1000).toFixed(2)}s
+              </span>
+              <Button
+                variant="ghost"
+                size="sm"
+                onClick={() => setOffset(offsetMs + 250)}
+              >
+                +0.25s
+              </Button>
+              {offsetMs !== 0 && (
+                <Button variant="ghost" size="sm" onClick={() => setOffset(0)}>
+                  Reset
+                </Button>
+              )}
+            </div>
+          )}
         </div>
         {parsedLyrics.length > 0 ? (
           <div className="space-y-3">
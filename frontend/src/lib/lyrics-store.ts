@@ -1,37 +1,66 @@
 import { create } from "zustand";
 
+export interface LyricLine {
+  timeMs: number;
+  text: string;
+}
+
 interface LyricsState {
   lyrics: string | null;
+  lines: LyricLine[];
   isLoading: boolean;
   isUnsynced: boolean;
+  offsetMs: number;
+  artist: string;
+  title: string;
   fetchLyrics: (artist: string, title: string) => Promise<void>;
+  setOffset: (offsetMs: number) => Promise<void>;
   clearLyrics: () => void;
 }
 
-export const useLyricsStore = create<LyricsState>((set) => ({
+const applyResult = (result: any) => ({
+  lyrics: result?.plain || null,
+  lines: result?.lines || [],
+  isUnsynced: !result?.synced,
+  offsetMs: result?.offsetMs || 0,
+});
+
+export const useLyricsStore = create<LyricsState>((set, get) => ({
   lyrics: null,
+  lines: [],
   isLoading: false,
   isUnsynced: true,
+  offsetMs: 0,
+  artist: "",
+  title: "",
 
   fetchLyrics: async (artist: string, title: string) => {
-    console.log("[Lyrics Store] Fetching lyrics for:", { artist, title });
-    set({ isLoading: true });
+    set({ isLoading: true, artist, title });
     try {
       const result = await window.go.main.App.GetLyrics(artist, title);
-      console.log("[Lyrics Store] API response:", result);
-      console.log("[Lyrics Store] Is unsynced:", result.unsynced !== false);
-      set({
-        lyrics: result.lyrics || null,
-        isUnsynced: result.unsynced !== false,
-        isLoading: false,
-      });
+      set({ ...applyResult(result), isLoading: false });
     } catch (error) {
       console.error("[Lyrics Store] Error fetching lyrics:", error);
-      set({ lyrics: null, isLoading: false });
+      set({ lyrics: null, lines: [], offsetMs: 0, isLoading: false });
+    }
+  },
+
+  setOffset: async (offsetMs: number) => {
+    const { artist, title } = get();
+    if (!artist || !title) return;
+    try {
+      const result = await window.go.main.App.SetLyricsOffset(
+        artist,
+        title,
+        offsetMs
+      );
+      set(applyResult(result));
+    } catch (error) {
+      console.error("[Lyrics Store] Error saving offset:", error);
     }
   },
 
   clearLyrics: () => {
-    set({ lyrics: null, isUnsynced: true });
+    set({ lyrics: null, lines: [], isUnsynced: true, offsetMs: 0 });
   },
 }));
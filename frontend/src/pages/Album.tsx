@@ -34,7 +34,7 @@ export function AlbumPage({ albumId, onBack }: AlbumPageProps) {
     addProcess(processId, "Loading Album Details");
     try {
       const result = await window.go.main.App.GetAlbumByID(albumId);
-      setAlbum(result.album);
+      setAlbum(result);
     } catch (error) {
       toast.error("Failed to load album");
     } finally {
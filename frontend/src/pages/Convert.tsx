@@ -25,6 +25,7 @@ import {
   TableRow,
 } from "@/components/ui/table";
 import { Badge } from "@/components/ui/badge";
+import { Label } from "@/components/ui/label";
 import {
   Dialog,
   DialogContent,
@@ -66,6 +67,16 @@ export function ConvertPage({
   const [lastTransferStats, setLastTransferStats] = useState<any>(null);
   const logEndRef = useRef<HTMLDivElement>(null);
   const abortControllerRef = useRef<AbortController | null>(null);
+  const conversionIdRef = useRef<string | null>(null);
+  const [session, setSession] = useState<any>(null);
+  const [searchRow, setSearchRow] = useState<number | null>(null);
+  const [searchQuery, setSearchQuery] = useState("");
+  const [searchResults, setSearchResults] = useState<any[]>([]);
+  const [checkpoints, setCheckpoints] = useState<any[]>([]);
+  const [libraries, setLibraries] = useState<any[]>([]);
+  const [syncLibraryId, setSyncLibraryId] = useState("");
+  const [removeMissing, setRemoveMissing] = useState(false);
+  const [watchSchedule, setWatchSchedule] = useState("1h");
   const { addProcess, removeProcess } = useProcessStore();
 
   useEffect(() => {
@@ -114,6 +125,66 @@ export function ConvertPage({
     logEndRef.current?.scrollIntoView({ behavior: "smooth" });
   }, [logs]);
 
+  useEffect(() => {
+    const id = localStorage.getItem("convert_sessionId");
+    if (!id || !window.go?.main?.App?.GetMatchSession) return;
+    window.go.main.App.GetMatchSession(id)
+      .then((saved: any) => {
+        if (saved && saved.status === "ready") {
+          setSession(saved);
+        } else {
+          localStorage.removeItem("convert_sessionId");
+        }
+      })
+      .catch(() => localStorage.removeItem("convert_sessionId"));
+  }, []);
+
+  const loadCheckpoints = async () => {
+    if (!window.go?.main?.App?.GetConversionCheckpoints) return;
+    try {
+      const list = await window.go.main.App.GetConversionCheckpoints();
+      setCheckpoints(
+        (list || []).filter(
+          (c: any) => c.status !== "completed" || c.failed > 0
+        )
+      );
+    } catch (e: any) {
+      console.error("Failed to load checkpoints", e);
+    }
+  };
+
+  useEffect(() => {
+    loadCheckpoints();
+    if (window.go?.main?.App?.GetLibraries) {
+      window.go.main.App.GetLibraries()
+        .then((libs: any[]) => setLibraries(libs || []))
+        .catch(() => setLibraries([]));
+    }
+  }, []);
+
+  const sessionStatus = (match: any) => {
+    if (match?.track) return "found";
+    if (match?.status === "review") return "review";
+    if (match?.status === "cancelled") return "cancelled";
+    return "not-found";
+  };
+
+  const applySession = (next: any) => {
+    setSession(next);
+    setTracks((prev) =>
+      prev.map((t, i) =>
+        next.tracks[i] ? { ...t, status: sessionStatus(next.tracks[i]) } : t
+      )
+    );
+  };
+
+  const clearSession = () => {
+    setSession(null);
+    setSearchRow(null);
+    setSearchResults([]);
+    localStorage.removeItem("convert_sessionId");
+  };
+
   const handleFetch = async () => {
     if (!url) return;
 
@@ -163,15 +234,169 @@ export function ConvertPage({
     }
   };
 
+  const finishTransfer = (stats: any, startTime: number) => {
+    const cancelled = stats.status === "cancelled";
+    setLastTransferStats({
+      totalTracks: stats.total,
+      addedTracks: stats.added,
+      failedTracks: stats.failed,
+      duration: Math.floor((Date.now() - startTime) / 1000),
+      cancelled,
+    });
+
+    if (cancelled) {
+      toast.info(`Conversion cancelled. ${stats.added} tracks added.`);
+    } else {
+      toast.success("Conversion process completed.");
+    }
+    setShowCompleteDialog(true);
+    if (onTransferComplete) {
+      onTransferComplete();
+    }
+  };
+
+  const handleStartReview = async () => {
+    if (tracks.length === 0) return;
+    setCreating(true);
+    setLogs([]);
+    clearSession();
+
+    const processId = `match-${Date.now()}`;
+    addProcess(processId, `Matching "${playlistName || "Playlist"}"`);
+
+    try {
+      const next = await window.go.main.App.StartMatchSession(
+        playlistName || "Imported Playlist",
+        "Imported via 0xDABmusic Desktop",
+        tracks,
+        url,
+        beginConversion()
+      );
+      applySession(next);
+      localStorage.setItem("convert_sessionId", next.id);
+      const review = next.tracks.filter((t: any) => !t.track).length;
+      toast.success(
+        review > 0
+          ? `${review} tracks need review before creating the library`
+          : "All tracks matched"
+      );
+    } catch (e: any) {
+      toast.error("Matching failed: " + e);
+    } finally {
+      conversionIdRef.current = null;
+      setCreating(false);
+      removeProcess(processId);
+    }
+  };
+
+  const handleSelectMatch = async (index: number, track: any | null) => {
+    if (!session) return;
+    try {
+      const next = await window.go.main.App.UpdateMatchSelection(session.id, {
+        index,
+        track,
+        skip: !track,
+      });
+      applySession(next);
+    } catch (e: any) {
+      toast.error("Failed to update selection: " + e);
+    }
+  };
+
+  const handleManualSearch = async () => {
+    if (!searchQuery.trim()) return;
+    try {
+      const results = await window.go.main.App.SearchDAB(searchQuery);
+      setSearchResults((results || []).slice(0, 10));
+    } catch (e: any) {
+      toast.error("Search failed: " + e);
+    }
+  };
+
+  const handleDiscardSession = async () => {
+    if (session && window.go?.main?.App?.DeleteMatchSession) {
+      try {
+        await window.go.main.App.DeleteMatchSession(session.id);
+      } catch (e: any) {
+        toast.error("Failed to discard review: " + e);
+      }
+    }
+    clearSession();
+  };
+
+  const handleCommitSession = async () => {
+    if (!session) return;
+    setCreating(true);
+    setLogs([]);
+
+    const startTime = Date.now();
+    const processId = `convert-${Date.now()}`;
+    addProcess(processId, `Converting "${playlistName || "Playlist"}"`);
+
+    try {
+      const stats = await window.go.main.App.CommitMatchSession(
+        session.id,
+        [],
+        beginConversion()
+      );
+      if (stats.status !== "cancelled") {
+        clearSession();
+      }
+      finishTransfer(stats, startTime);
+    } catch (e: any) {
+      toast.error("Failed to create library: " + e);
+    } finally {
+      conversionIdRef.current = null;
+      setCreating(false);
+      removeProcess(processId);
+      loadCheckpoints();
+    }
+  };
+
+  const handleDryRun = async () => {
+    if (tracks.length === 0) return;
+    setCreating(true);
+    setLogs([]);
+
+    const processId = `dryrun-${Date.now()}`;
+    addProcess(processId, `Dry run "${playlistName || "Playlist"}"`);
+
+    try {
+      const report = await window.go.main.App.DryRunConversion(
+        tracks,
+        beginConversion()
+      );
+      const misses = report.tracks
+        .filter((t: any) => !t.matched)
+        .map(
+          (t: any) =>
+            `✗ ${t.source.artist} - ${t.source.title}: ${t.status}` +
+            (t.best
+              ? ` (best: ${t.best.artist} - ${t.best.title}, ${t.score}%)`
+              : "") +
+            (t.query ? ` [query: ${t.query}]` : "")
+        );
+      setLogs((prev) => [...prev, ...misses]);
+      toast.success(
+        `Dry run: ${report.matched}/${report.total} would transfer (${Math.round(
+          report.matchRate * 100
+        )}%)`
+      );
+    } catch (e: any) {
+      toast.error("Dry run failed: " + e);
+    } finally {
+      conversionIdRef.current = null;
+      setCreating(false);
+      removeProcess(processId);
+    }
+  };
+
   const handleCreateLibrary = async () => {
     if (tracks.length === 0) return;
     setCreating(true);
     setLogs([]);
 
     const startTime = Date.now();
-    let matchedCount = 0;
-    let addedCount = 0;
-    let failedCount = 0;
 
     const processId = `convert-${Date.now()}`;
     addProcess(processId, `Converting "${playlistName || "Playlist"}"`);
@@ -181,54 +406,138 @@ export function ConvertPage({
         const stats = await window.go.main.App.CreateDABLibrary(
           playlistName || "Imported Playlist",
           "Imported via 0xDABmusic Desktop",
-          tracks
+          tracks,
+          url,
+          beginConversion()
         );
+        finishTransfer(stats, startTime);
+      }
+    } catch (e: any) {
+      toast.error("Failed to create library: " + e);
+    } finally {
+      conversionIdRef.current = null;
+      setCreating(false);
+      removeProcess(processId);
+      loadCheckpoints();
+    }
+  };
 
-        matchedCount = stats.matched;
-        addedCount = stats.added;
-        failedCount = stats.failed;
-
-        const transferRecord = {
-          playlistName: playlistName || "Imported Playlist",
-          sourceURL: url,
-          totalTracks: stats.total,
-          matchedTracks: matchedCount,
-          addedTracks: addedCount,
-          failedTracks: failedCount,
-          status: "completed",
-          createdAt: new Date(),
-          completedAt: new Date(),
-          libraryID: "",
-          duration: Math.floor((Date.now() - startTime) / 1000),
-          source: url.includes("youtube") ? "YouTube" : "Spotify",
-        };
-
-        setLastTransferStats(transferRecord);
-
-        if (window.go?.main?.App?.RecordTransfer) {
-          await window.go.main.App.RecordTransfer(transferRecord);
-        }
+  const handleResumeConversion = async (id: string) => {
+    setCreating(true);
+    setLogs([]);
+    clearSession();
 
-        toast.success("Conversion process completed.");
-        setShowCompleteDialog(true);
-        if (onTransferComplete) {
-          onTransferComplete();
-        }
+    const startTime = Date.now();
+    const processId = `resume-${Date.now()}`;
+
+    try {
+      const checkpoint = await window.go.main.App.GetConversionCheckpoint(id);
+      setPlaylistName(checkpoint.name);
+      setTracks(
+        checkpoint.tracks.map((t: any) => ({ ...t.source, status: "pending" }))
+      );
+      addProcess(processId, `Resuming "${checkpoint.name}"`);
+
+      const stats = await window.go.main.App.ResumeConversion(
+        id,
+        beginConversion()
+      );
+      finishTransfer(stats, startTime);
+    } catch (e: any) {
+      toast.error("Failed to resume conversion: " + e);
+    } finally {
+      conversionIdRef.current = null;
+      setCreating(false);
+      removeProcess(processId);
+      loadCheckpoints();
+    }
+  };
+
+  const handleDiscardCheckpoint = async (id: string) => {
+    try {
+      await window.go.main.App.DeleteConversionCheckpoint(id);
+    } catch (e: any) {
+      toast.error("Failed to discard conversion: " + e);
+    }
+    loadCheckpoints();
+  };
+
+  const handleSync = async () => {
+    if (!url || !syncLibraryId) return;
+    setCreating(true);
+    setLogs([]);
+    clearSession();
+    setTracks((prev) => prev.map((t) => ({ ...t, status: "pending" })));
+
+    const processId = `sync-${Date.now()}`;
+    addProcess(processId, `Syncing "${playlistName || "Playlist"}"`);
+
+    try {
+      const result = await window.go.main.App.SyncLibrary(
+        url,
+        syncLibraryId,
+        removeMissing,
+        beginConversion()
+      );
+      toast.success(
+        `Synced: ${result.added} added, ${result.removed} removed, ${result.unchanged} unchanged`
+      );
+      if (onTransferComplete) {
+        onTransferComplete();
       }
     } catch (e: any) {
-      toast.error("Failed to create library: " + e);
+      toast.error("Sync failed: " + e);
     } finally {
+      conversionIdRef.current = null;
       setCreating(false);
       removeProcess(processId);
     }
   };
 
+  const handleWatch = async () => {
+    if (!url || !syncLibraryId) return;
+    try {
+      const watch = await window.go.main.App.SaveWatchedPlaylist({
+        name: playlistName || url,
+        sourceURL: url,
+        libraryID: syncLibraryId,
+        schedule: watchSchedule,
+        removeMissing,
+        enabled: true,
+      });
+      toast.success(
+        `Watching "${watch.name}", next sync ${new Date(
+          watch.nextRunAt
+        ).toLocaleString()}`
+      );
+    } catch (e: any) {
+      toast.error("Failed to watch playlist: " + e);
+    }
+  };
+
+  const beginConversion = () => {
+    const id = `conversion_${Date.now()}`;
+    conversionIdRef.current = id;
+    return id;
+  };
+
+  const handleCancelConversion = async () => {
+    const id = conversionIdRef.current;
+    if (!id || !window.go?.main?.App?.CancelConversion) return;
+    try {
+      await window.go.main.App.CancelConversion(id);
+    } catch (e: any) {
+      toast.error("Failed to cancel: " + e);
+    }
+  };
+
   const handleReset = () => {
     if (abortControllerRef.current) {
       abortControllerRef.current.abort();
       abortControllerRef.current = null;
     }
 
+    clearSession();
     setUrl("");
     setTracks([]);
     setPlaylistName("");
@@ -247,6 +556,10 @@ export function ConvertPage({
         return <Badge variant="secondary">Searching...</Badge>;
       case "found":
         return <Badge className="bg-blue-500 hover:bg-blue-600">Found</Badge>;
+      case "review":
+        return (
+          <Badge className="bg-orange-500 hover:bg-orange-600">Review</Badge>
+        );
       case "not-found":
         return <Badge variant="destructive">Not Found</Badge>;
       case "adding":
@@ -255,6 +568,10 @@ export function ConvertPage({
         );
       case "added":
         return <Badge className="bg-green-500 hover:bg-green-600">Added</Badge>;
+      case "synced":
+        return <Badge variant="secondary">In Library</Badge>;
+      case "cancelled":
+        return <Badge variant="outline">Cancelled</Badge>;
       case "error":
         return (
           <Badge variant="destructive" title={error}>
@@ -266,6 +583,87 @@ export function ConvertPage({
     }
   };
 
+  const renderMatchCell = (index: number) => {
+    const match = session?.tracks?.[index];
+    if (!match) return null;
+    const candidates: any[] = match.candidates || [];
+    const selectedId = match.track ? String(match.track.id) : "";
+    const candidateIndex = candidates.findIndex(
+      (c) => String(c.track.id) === selectedId
+    );
+    const value = match.track
+      ? candidateIndex >= 0
+        ? String(candidateIndex)
+        : "manual"
+      : "skip";
+
+    return (
+      <div className="space-y-2">
+        <Select
+          value={value}
+          onValueChange={(v) => {
+            if (v === "skip") {
+              handleSelectMatch(index, null);
+            } else if (v === "search") {
+              setSearchRow(index);
+              setSearchQuery(`${match.source.artist} ${match.source.title}`);
+              setSearchResults([]);
+            } else if (v !== "manual") {
+              handleSelectMatch(index, candidates[Number(v)].track);
+            }
+          }}
+        >
+          <SelectTrigger className="w-full">
+            <SelectValue placeholder="Choose match" />
+          </SelectTrigger>
+          <SelectContent>
+            {candidates.map((c, ci) => (
+              <SelectItem key={ci} value={String(ci)}>
+                {c.track.artist} - {c.track.title} ({c.score}%)
+              </SelectItem>
+            ))}
+            {value === "manual" && (
+              <SelectItem value="manual">
+                {match.track.artist} - {match.track.title} (manual)
+              </SelectItem>
+            )}
+            <SelectItem value="search">Search DAB…</SelectItem>
+            <SelectItem value="skip">Skip track</SelectItem>
+          </SelectContent>
+        </Select>
+        {searchRow === index && (
+          <div className="space-y-2">
+            <div className="flex gap-2">
+              <Input
+                value={searchQuery}
+                onChange={(e) => setSearchQuery(e.target.value)}
+                onKeyDown={(e) => e.key === "Enter" && handleManualSearch()}
+                className="bg-slate-900 border-slate-800 text-white placeholder:text-slate-400"
+              />
+              <Button size="sm" onClick={handleManualSearch}>
+                Search
+              </Button>
+            </div>
+            {searchResults.map((r, ri) => (
+              <button
+                key={ri}
+                className="block w-full text-left text-xs p-1 rounded hover:bg-slate-800"
+                onClick={() => {
+                  handleSelectMatch(index, r);
+                  setSearchRow(null);
+                  setSearchResults([]);
+                }}
+              >
+                {r.artist} - {r.title}
+                {r.albumTitle ? ` · ${r.albumTitle}` : ""}
+              </button>
+            ))}
+          </div>
+        )}
+      </div>
+    );
+  };
+
   return (
     <div className="space-y-6">
       <div>
@@ -315,6 +713,49 @@ export function ConvertPage({
         </CardContent>
       </Card>
 
+      {!creating && checkpoints.length > 0 && (
+        <Card>
+          <CardHeader>
+            <CardTitle>Unfinished Conversions</CardTitle>
+            <CardDescription>
+              Resume an interrupted conversion without creating a new library.
+            </CardDescription>
+          </CardHeader>
+          <CardContent className="space-y-2">
+            {checkpoints.map((c) => (
+              <div
+                key={c.id}
+                className="flex items-center justify-between gap-2 text-sm"
+              >
+                <div>
+                  <div className="font-medium">{c.name}</div>
+                  <div className="text-muted-foreground">
+                    {c.added}/{c.matched} added · {c.totalTracks} tracks ·{" "}
+                    {c.status}
+                    {c.failed > 0 ? ` · ${c.failed} failed` : ""}
+                  </div>
+                </div>
+                <div className="flex gap-2">
+                  <Button
+                    size="sm"
+                    variant="outline"
+                    onClick={() => handleDiscardCheckpoint(c.id)}
+                  >
+                    Discard
+                  </Button>
+                  <Button
+                    size="sm"
+                    onClick={() => handleResumeConversion(c.id)}
+                  >
+                    Resume
+                  </Button>
+                </div>
+              </div>
+            ))}
+          </CardContent>
+        </Card>
+      )}
+
       {!loading && tracks.length > 0 && (
         <Card>
           <CardHeader>
@@ -332,6 +773,7 @@ export function ConvertPage({
                     <TableHead>Artist</TableHead>
                     <TableHead>Duration</TableHead>
                     <TableHead>Status</TableHead>
+                    {session && <TableHead>Match</TableHead>}
                   </TableRow>
                 </TableHeader>
                 <TableBody>
@@ -347,6 +789,11 @@ export function ConvertPage({
                       <TableCell>
                         {getStatusBadge(track.status, track.error)}
                       </TableCell>
+                      {session && (
+                        <TableCell className="min-w-64">
+                          {renderMatchCell(i)}
+                        </TableCell>
+                      )}
                     </TableRow>
                   ))}
                 </TableBody>
@@ -367,10 +814,94 @@ export function ConvertPage({
               </div>
             )}
 
-            <div className="flex justify-end">
-              <Button onClick={handleCreateLibrary} disabled={creating}>
-                {creating ? "Creating..." : "Create Library on DAB"}
-              </Button>
+            {!session && libraries.length > 0 && (
+              <div className="flex items-center gap-2 mb-4">
+                <Select value={syncLibraryId} onValueChange={setSyncLibraryId}>
+                  <SelectTrigger className="flex-1">
+                    <SelectValue placeholder="Sync into existing library..." />
+                  </SelectTrigger>
+                  <SelectContent>
+                    {libraries.map((lib) => (
+                      <SelectItem key={lib.id} value={String(lib.id)}>
+                        {lib.name}
+                      </SelectItem>
+                    ))}
+                  </SelectContent>
+                </Select>
+                <input
+                  type="checkbox"
+                  id="sync-remove-missing"
+                  checked={removeMissing}
+                  onChange={(e) => setRemoveMissing(e.target.checked)}
+                  className="h-4 w-4 rounded border-white/10 bg-white/5"
+                />
+                <Label htmlFor="sync-remove-missing" className="text-sm">
+                  Remove dropped tracks
+                </Label>
+                <Button
+                  variant="outline"
+                  onClick={handleSync}
+                  disabled={creating || !syncLibraryId}
+                >
+                  Sync
+                </Button>
+                <Input
+                  value={watchSchedule}
+                  onChange={(e) => setWatchSchedule(e.target.value)}
+                  placeholder="1h or 0 9 * * *"
+                  title="Interval (e.g. 6h) or cron expression"
+                  className="w-32 bg-slate-900 border-slate-800 text-white placeholder:text-slate-400"
+                />
+                <Button
+                  variant="outline"
+                  onClick={handleWatch}
+                  disabled={creating || !syncLibraryId}
+                >
+                  Watch
+                </Button>
+              </div>
+            )}
+
+            <div className="flex justify-end gap-2">
+              {creating && (
+                <Button variant="outline" onClick={handleCancelConversion}>
+                  Cancel
+                </Button>
+              )}
+              {session ? (
+                <>
+                  <Button
+                    variant="outline"
+                    onClick={handleDiscardSession}
+                    disabled={creating}
+                  >
+                    Discard Review
+                  </Button>
+                  <Button onClick={handleCommitSession} disabled={creating}>
+                    {creating ? "Creating..." : "Create Library from Review"}
+                  </Button>
+                </>
+              ) : (
+                <>
+                  <Button
+                    variant="outline"
+                    onClick={handleDryRun}
+                    disabled={creating}
+                  >
+                    Dry Run
+                  </Button>
+                  <Button
+                    variant="outline"
+                    onClick={handleStartReview}
+                    disabled={creating}
+                  >
+                    Match & Review
+                  </Button>
+                  <Button onClick={handleCreateLibrary} disabled={creating}>
+                    {creating ? "Creating..." : "Create Library on DAB"}
+                  </Button>
+                </>
+              )}
             </div>
           </CardContent>
         </Card>
@@ -379,9 +910,15 @@ export function ConvertPage({
       <Dialog open={showCompleteDialog} onOpenChange={setShowCompleteDialog}>
         <DialogContent>
           <DialogHeader>
-            <DialogTitle>Conversion Complete</DialogTitle>
+            <DialogTitle>
+              {lastTransferStats?.cancelled
+                ? "Conversion Cancelled"
+                : "Conversion Complete"}
+            </DialogTitle>
             <DialogDescription>
-              The library has been successfully created on DAB.
+              {lastTransferStats?.cancelled
+                ? "The conversion was stopped. It can be resumed from its checkpoint."
+                : "The library has been successfully created on DAB."}
             </DialogDescription>
           </DialogHeader>
 
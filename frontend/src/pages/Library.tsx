@@ -304,7 +304,9 @@ export function LibraryPage({ navigateTo }: LibraryPageProps) {
         await window.go.main.App.CreateDABLibrary(
           newLibraryName,
           "Created from Desktop App",
-          [trackInfo]
+          [trackInfo],
+          "",
+          ""
         );
         toast.success("Library created and track added");
       } else {
@@ -35,11 +35,88 @@ export function SettingsPage() {
   const [dabEmail, setDabEmail] = useState("");
   const [dabApiBase, setDabApiBase] = useState("https://dabmusic.xyz/api");
   const [fuzzyScale, setFuzzyScale] = useState(85);
+  const [durationTolerance, setDurationTolerance] = useState(3);
+  const [versionPolicy, setVersionPolicy] = useState({
+    explicit: "source",
+    edition: "source",
+    performance: "source",
+    quality: "highest",
+    tieMargin: 5,
+  });
   const [maxConcurrency, setMaxConcurrency] = useState(1);
   const [maxCacheSize, setMaxCacheSize] = useState(1024);
   const [crossfadeDuration, setCrossfadeDuration] = useState(0);
   const [spotifyAuthenticated, setSpotifyAuthenticated] = useState(false);
   const [spotifyAuthUrl, setSpotifyAuthUrl] = useState("");
+  const [matchCache, setMatchCache] = useState<any[]>([]);
+  const [watches, setWatches] = useState<any[]>([]);
+
+  const loadMatchCache = async () => {
+    if (window.go?.main?.App?.GetMatchCache) {
+      const entries = await window.go.main.App.GetMatchCache();
+      setMatchCache(entries || []);
+    }
+  };
+
+  const handleDeleteMatch = async (key: string) => {
+    try {
+      await window.go.main.App.DeleteMatchCacheEntry(key);
+      loadMatchCache();
+    } catch (e: any) {
+      toast.error("Failed to delete match: " + e);
+    }
+  };
+
+  const handleClearMatchCache = async () => {
+    try {
+      await window.go.main.App.ClearMatchCache();
+      setMatchCache([]);
+      toast.success("Match cache cleared");
+    } catch (e: any) {
+      toast.error("Failed to clear match cache: " + e);
+    }
+  };
+
+  const loadWatches = async () => {
+    if (window.go?.main?.App?.GetWatchedPlaylists) {
+      const list = await window.go.main.App.GetWatchedPlaylists();
+      setWatches(list || []);
+    }
+  };
+
+  const handleToggleWatch = async (watch: any) => {
+    try {
+      await window.go.main.App.SaveWatchedPlaylist({
+        ...watch,
+        enabled: !watch.enabled,
+      });
+      loadWatches();
+    } catch (e: any) {
+      toast.error("Failed to update watch: " + e);
+    }
+  };
+
+  const handleRunWatch = async (watch: any) => {
+    toast.info(`Syncing "${watch.name}"...`);
+    try {
+      const result = await window.go.main.App.RunWatchedPlaylist(watch.id);
+      toast.success(
+        `"${watch.name}": ${result.added} added, ${result.removed} removed`
+      );
+    } catch (e: any) {
+      toast.error("Sync failed: " + e);
+    }
+    loadWatches();
+  };
+
+  const handleDeleteWatch = async (id: string) => {
+    try {
+      await window.go.main.App.DeleteWatchedPlaylist(id);
+      loadWatches();
+    } catch (e: any) {
+      toast.error("Failed to remove watch: " + e);
+    }
+  };
 
   const normalizeApiBase = (value: string) => {
     const v = String(value || "")
@@ -62,6 +139,7 @@ export function SettingsPage() {
             setDabApiBase(base);
           }
           setFuzzyScale(cfg.FUZZY_MATCH_SCALE || 85);
+          setDurationTolerance(cfg.MATCH_DURATION_TOLERANCE || 3);
           setMaxConcurrency(cfg.MAX_CONCURRENCY || 1);
 
           const sizeMB = (cfg.MAX_CACHE_SIZE || 1073741824) / (1024 * 1024);
@@ -70,6 +148,15 @@ export function SettingsPage() {
       });
     }
 
+    loadMatchCache();
+    loadWatches();
+
+    if (window.go?.main?.App?.GetVersionPolicy) {
+      window.go.main.App.GetVersionPolicy().then((policy: any) => {
+        if (policy) setVersionPolicy(policy);
+      });
+    }
+
     const storedCrossfade = localStorage.getItem("crossfadeDuration");
     if (storedCrossfade) {
       setCrossfadeDuration(Number(storedCrossfade));
@@ -115,6 +202,17 @@ export function SettingsPage() {
           Number(maxConcurrency),
           Number(sizeBytes)
         );
+        if (window.go?.main?.App?.SetMatchDurationTolerance) {
+          await window.go.main.App.SetMatchDurationTolerance(
+            Number(durationTolerance)
+          );
+        }
+        if (window.go?.main?.App?.SaveVersionPolicy) {
+          await window.go.main.App.SaveVersionPolicy({
+            ...versionPolicy,
+            tieMargin: Number(versionPolicy.tieMargin),
+          });
+        }
         toast.success("General settings saved");
       } catch (e: any) {
         toast.error("Failed to save: " + e);
@@ -312,6 +410,119 @@ export function SettingsPage() {
               </div>
             </div>
 
+            <div className="grid gap-2">
+              <Label htmlFor="duration-tolerance">
+                Duration Tolerance (seconds)
+              </Label>
+              <div className="flex gap-4 items-center">
+                <Input
+                  id="duration-tolerance"
+                  type="number"
+                  min="1"
+                  max="60"
+                  value={durationTolerance}
+                  onChange={(e) => setDurationTolerance(Number(e.target.value))}
+                  className="bg-slate-900 border-slate-800 text-white placeholder:text-slate-400 w-24"
+                />
+                <span className="text-sm text-muted-foreground">
+                  Tracks whose length differs by more than this lose match
+                  score, so edits and extended mixes are not picked. Default
+                  is 3.
+                </span>
+              </div>
+            </div>
+
+            <div className="grid gap-2">
+              <Label>Version Preferences</Label>
+              <div className="grid grid-cols-2 gap-4">
+                {(
+                  [
+                    [
+                      "explicit",
+                      "Explicit content",
+                      [
+                        ["source", "Match source"],
+                        ["explicit", "Prefer explicit"],
+                        ["clean", "Prefer clean"],
+                        ["any", "No preference"],
+                      ],
+                    ],
+                    [
+                      "edition",
+                      "Edition",
+                      [
+                        ["source", "Match source"],
+                        ["original", "Prefer original"],
+                        ["remaster", "Prefer remaster"],
+                        ["any", "No preference"],
+                      ],
+                    ],
+                    [
+                      "performance",
+                      "Performance",
+                      [
+                        ["source", "Match source"],
+                        ["studio", "Prefer studio"],
+                        ["live", "Prefer live"],
+                        ["any", "No preference"],
+                      ],
+                    ],
+                    [
+                      "quality",
+                      "Audio quality",
+                      [
+                        ["highest", "Prefer highest"],
+                        ["any", "No preference"],
+                      ],
+                    ],
+                  ] as [keyof typeof versionPolicy, string, string[][]][]
+                ).map(([key, label, options]) => (
+                  <div key={key} className="grid gap-1">
+                    <span className="text-xs text-muted-foreground">
+                      {label}
+                    </span>
+                    <Select
+                      value={String(versionPolicy[key])}
+                      onValueChange={(v) =>
+                        setVersionPolicy((prev) => ({ ...prev, [key]: v }))
+                      }
+                    >
+                      <SelectTrigger>
+                        <SelectValue />
+                      </SelectTrigger>
+                      <SelectContent>
+                        {options.map(([value, text]) => (
+                          <SelectItem key={value} value={value}>
+                            {text}
+                          </SelectItem>
+                        ))}
+                      </SelectContent>
+                    </Select>
+                  </div>
+                ))}
+              </div>
+              <div className="flex gap-4 items-center">
+                <Input
+                  id="tie-margin"
+                  type="number"
+                  min="0"
+                  max="100"
+                  value={versionPolicy.tieMargin}
+                  onChange={(e) =>
+                    setVersionPolicy((prev) => ({
+                      ...prev,
+                      tieMargin: Number(e.target.value),
+                    }))
+                  }
+                  className="bg-slate-900 border-slate-800 text-white placeholder:text-slate-400 w-24"
+                />
+                <span className="text-sm text-muted-foreground">
+                  Candidates within this many points of the best score are
+                  ranked by the preferences above. Default is 5.
+                </span>
+              </div>
+            </div>
+
             <Separator className="bg-slate-800" />
 
             <div className="grid gap-2">
@@ -398,6 +609,127 @@ export function SettingsPage() {
           </CardContent>
         </Card>
 
+        <Card>
+          <CardHeader>
+            <CardTitle>Match Cache</CardTitle>
+            <CardDescription>
+              Remembered source-to-DAB matches reused by future conversions.
+            </CardDescription>
+          </CardHeader>
+          <CardContent className="space-y-4">
+            <div className="flex items-center justify-between">
+              <span className="text-sm text-muted-foreground">
+                {matchCache.length} cached matches
+              </span>
+              <Button
+                variant="destructive"
+                size="sm"
+                disabled={matchCache.length === 0}
+                onClick={handleClearMatchCache}
+              >
+                Clear All
+              </Button>
+            </div>
+            {matchCache.length > 0 && (
+              <div className="max-h-64 overflow-y-auto space-y-2">
+                {matchCache.map((entry) => (
+                  <div
+                    key={entry.key}
+                    className="flex items-center justify-between gap-4 text-sm border border-slate-800 rounded-md p-2"
+                  >
+                    <div className="min-w-0">
+                      <p className="truncate">
+                        {entry.sourceArtist} - {entry.sourceTitle}
+                      </p>
+                      <p className="truncate text-xs text-muted-foreground">
+                        → {entry.track?.artist} - {entry.track?.title} (
+                        {entry.score}%){entry.manual ? " · manual" : ""}
+                      </p>
+                    </div>
+                    <Button
+                      variant="ghost"
+                      size="sm"
+                      onClick={() => handleDeleteMatch(entry.key)}
+                    >
+                      Remove
+                    </Button>
+                  </div>
+                ))}
+              </div>
+            )}
+          </CardContent>
+        </Card>
+
+        <Card>
+          <CardHeader>
+            <CardTitle>Watched Playlists</CardTitle>
+            <CardDescription>
+              Playlists re-synced into their DAB library on a schedule while
+              the app is running.
+            </CardDescription>
+          </CardHeader>
+          <CardContent className="space-y-2">
+            {watches.length === 0 && (
+              <p className="text-sm text-muted-foreground">
+                No watched playlists. Use "Watch" on the Convert page to add
+                one.
+              </p>
+            )}
+            {watches.map((watch) => (
+              <div
+                key={watch.id}
+                className="flex items-center justify-between gap-4 text-sm border border-slate-800 rounded-md p-2"
+              >
+                <div className="min-w-0">
+                  <p className="truncate">{watch.name}</p>
+                  <p className="truncate text-xs text-muted-foreground">
+                    {watch.schedule}
+                    {watch.removeMissing ? " · mirrors removals" : ""}
+                    {watch.lastRunAt
+                      ? ` · last ${new Date(
+                          watch.lastRunAt
+                        ).toLocaleString()} (${watch.lastStatus}, +${
+                          watch.lastAdded
+                        })`
+                      : ""}
+                    {watch.enabled && watch.nextRunAt
+                      ? ` · next ${new Date(watch.nextRunAt).toLocaleString()}`
+                      : ""}
+                  </p>
+                  {watch.lastError && (
+                    <p className="truncate text-xs text-red-400">
+                      {watch.lastError}
+                    </p>
+                  )}
+                </div>
+                <div className="flex gap-2">
+                  <Button
+                    variant="ghost"
+                    size="sm"
+                    onClick={() => handleRunWatch(watch)}
+                  >
+                    Run Now
+                  </Button>
+                  <Button
+                    variant="ghost"
+                    size="sm"
+                    onClick={() => handleToggleWatch(watch)}
+                  >
+                    {watch.enabled ? "Pause" : "Resume"}
+                  </Button>
+                  <Button
+                    variant="ghost"
+                    size="sm"
+                    onClick={() => handleDeleteWatch(watch.id)}
+                  >
+                    Remove
+                  </Button>
+                </div>
+              </div>
+            ))}
+          </CardContent>
+        </Card>
+
         <Card>
           <CardHeader>
             <CardTitle>DAB Account</CardTitle>
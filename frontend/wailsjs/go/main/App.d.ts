@@ -6,6 +6,8 @@ export function AddToFavorites(arg1:services.DABTrack):Promise<void>;
 
 export function AddToLibrary(arg1:string,arg2:services.TrackInfo):Promise<void>;
 
+export function CancelConversion(arg1:string):Promise<void>;
+
 export function CheckDABSession():Promise<boolean>;
 
 export function CheckSpotifySession():Promise<boolean>;
@@ -14,28 +16,56 @@ export function ClearAllCache():Promise<void>;
 
 export function ClearDownloadHistory():Promise<void>;
 
+export function ClearMatchCache():Promise<void>;
+
 export function ClearQueue():Promise<void>;
 
 export function ClearTransferHistory():Promise<void>;
 
-export function CreateDABLibrary(arg1:string,arg2:string,arg3:Array<services.TrackInfo>):Promise<services.TransferStats>;
+export function CommitMatchSession(arg1:string,arg2:Array<services.MatchSelection>,arg3:string):Promise<services.TransferStats>;
+
+export function CorrectMatchCacheEntry(arg1:string,arg2:services.DABTrack):Promise<void>;
+
+export function CreateDABLibrary(arg1:string,arg2:string,arg3:Array<services.TrackInfo>,arg4:string,arg5:string):Promise<services.TransferStats>;
 
 export function DABLogin(arg1:string,arg2:string):Promise<void>;
 
+export function DeleteConversionCheckpoint(arg1:string):Promise<void>;
+
 export function DeleteLibrary(arg1:string):Promise<void>;
 
+export function DeleteMatchCacheEntry(arg1:string):Promise<void>;
+
+export function DeleteMatchSession(arg1:string):Promise<void>;
+
 export function DeleteTransferRecord(arg1:string):Promise<void>;
 
+export function DeleteWatchedPlaylist(arg1:string):Promise<void>;
+
 export function DownloadTrack(arg1:services.DABTrack):Promise<string>;
 
-export function GetAlbumByID(arg1:string):Promise<Record<string, any>>;
+export function DryRunConversion(arg1:Array<services.TrackInfo>,arg2:string):Promise<services.DryRunReport>;
+
+export function GetActiveDABMirror():Promise<services.DABMirror>;
+
+export function GetAlbumByID(arg1:string):Promise<services.DABAlbum>;
 
 export function GetAppVersion():Promise<string>;
 
+export function GetArtist(arg1:string):Promise<services.DABArtist>;
+
+export function GetArtistDiscography(arg1:string):Promise<Array<services.DABAlbum>>;
+
 export function GetConfig():Promise<services.Config>;
 
+export function GetConversionCheckpoint(arg1:string):Promise<services.ConversionCheckpoint>;
+
+export function GetConversionCheckpoints():Promise<Array<services.CheckpointSummary>>;
+
 export function GetCurrentUser():Promise<Record<string, any>>;
 
+export function GetDABMirrors():Promise<Array<services.DABMirror>>;
+
 export function GetDownloadHistory():Promise<Array<services.DownloadItem>>;
 
 export function GetDownloadQueue():Promise<Array<services.DownloadItem>>;
@@ -46,7 +76,13 @@ export function GetLibraries():Promise<Array<services.Library>>;
 
 export function GetLibraryDetails(arg1:string):Promise<services.LibraryDetailsResponse>;
 
-export function GetLyrics(arg1:string,arg2:string):Promise<Record<string, any>>;
+export function GetLyrics(arg1:string,arg2:string):Promise<services.Lyrics>;
+
+export function GetMatchCache():Promise<Array<services.MatchEntry>>;
+
+export function GetMatchSession(arg1:string):Promise<services.MatchSession>;
+
+export function GetMatchSessions():Promise<Array<services.MatchSessionSummary>>;
 
 export function GetQueue():Promise<Array<services.DABTrack>>;
 
@@ -58,6 +94,10 @@ export function GetTotalCacheSize():Promise<string>;
 
 export function GetTransferHistory():Promise<Array<services.TransferRecord>>;
 
+export function GetVersionPolicy():Promise<services.VersionPolicy>;
+
+export function GetWatchedPlaylists():Promise<Array<services.WatchedPlaylist>>;
+
 export function Greet(arg1:string):Promise<string>;
 
 export function Logout():Promise<void>;
@@ -76,16 +116,46 @@ export function RemoveFromFavorites(arg1:string):Promise<void>;
 
 export function RemoveFromLibrary(arg1:string,arg2:string):Promise<void>;
 
+export function ResumeConversion(arg1:string,arg2:string):Promise<services.TransferStats>;
+
+export function RetryFailedTracks(arg1:string,arg2:string):Promise<services.TransferRecord>;
+
+export function RunWatchedPlaylist(arg1:string):Promise<services.SyncResult>;
+
 export function SaveConfig(arg1:string,arg2:string):Promise<void>;
 
 export function SaveGeneralSettings(arg1:number,arg2:number,arg3:number):Promise<void>;
 
 export function SaveQueue(arg1:Array<services.DABTrack>):Promise<void>;
 
+export function SaveTLSSettings(arg1:string,arg2:string,arg3:Array<string>):Promise<void>;
+
+export function SaveVersionPolicy(arg1:services.VersionPolicy):Promise<void>;
+
+export function SaveWatchedPlaylist(arg1:services.WatchedPlaylist):Promise<services.WatchedPlaylist>;
+
+export function SearchAdvanced(arg1:string,arg2:services.SearchFilters):Promise<services.SearchResult>;
+
 export function SearchDAB(arg1:string):Promise<Array<services.DABTrack>>;
 
 export function SetDABAPIBase(arg1:string):Promise<void>;
 
+export function SetDABAPIBases(arg1:Array<string>):Promise<void>;
+
+export function SetDABRateLimit(arg1:number):Promise<void>;
+
+export function SetLyricsOffset(arg1:string,arg2:string,arg3:number):Promise<services.Lyrics>;
+
+export function SetMatchDurationTolerance(arg1:number):Promise<void>;
+
 export function SpotifyLogin():Promise<string>;
 
+export function StartMatchSession(arg1:string,arg2:string,arg3:Array<services.TrackInfo>,arg4:string,arg5:string):Promise<services.MatchSession>;
+
+export function StreamLibraryTracks(arg1:string,arg2:number):Promise<number>;
+
+export function SyncLibrary(arg1:string,arg2:string,arg3:boolean,arg4:string):Promise<services.SyncResult>;
+
 export function UpdateLibrary(arg1:string,arg2:string,arg3:string,arg4:boolean):Promise<void>;
+
+export function UpdateMatchSelection(arg1:string,arg2:services.MatchSelection):Promise<services.MatchSession>;
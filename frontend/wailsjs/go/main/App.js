@@ -10,6 +10,10 @@ export function AddToLibrary(arg1, arg2) {
   return window['go']['main']['App']['AddToLibrary'](arg1, arg2);
 }
 
+export function CancelConversion(arg1) {
+  return window['go']['main']['App']['CancelConversion'](arg1);
+}
+
 export function CheckDABSession() {
   return window['go']['main']['App']['CheckDABSession']();
 }
@@ -26,6 +30,10 @@ export function ClearDownloadHistory() {
   return window['go']['main']['App']['ClearDownloadHistory']();
 }
 
+export function ClearMatchCache() {
+  return window['go']['main']['App']['ClearMatchCache']();
+}
+
 export function ClearQueue() {
   return window['go']['main']['App']['ClearQueue']();
 }
@@ -34,26 +42,58 @@ export function ClearTransferHistory() {
   return window['go']['main']['App']['ClearTransferHistory']();
 }
 
-export function CreateDABLibrary(arg1, arg2, arg3) {
-  return window['go']['main']['App']['CreateDABLibrary'](arg1, arg2, arg3);
+export function CommitMatchSession(arg1, arg2, arg3) {
+  return window['go']['main']['App']['CommitMatchSession'](arg1, arg2, arg3);
+}
+
+export function CorrectMatchCacheEntry(arg1, arg2) {
+  return window['go']['main']['App']['CorrectMatchCacheEntry'](arg1, arg2);
+}
+
+export function CreateDABLibrary(arg1, arg2, arg3, arg4, arg5) {
+  return window['go']['main']['App']['CreateDABLibrary'](arg1, arg2, arg3, arg4, arg5);
 }
 
 export function DABLogin(arg1, arg2) {
   return window['go']['main']['App']['DABLogin'](arg1, arg2);
 }
 
+export function DeleteConversionCheckpoint(arg1) {
+  return window['go']['main']['App']['DeleteConversionCheckpoint'](arg1);
+}
+
 export function DeleteLibrary(arg1) {
   return window['go']['main']['App']['DeleteLibrary'](arg1);
 }
 
+export function DeleteMatchCacheEntry(arg1) {
+  return window['go']['main']['App']['DeleteMatchCacheEntry'](arg1);
+}
+
+export function DeleteMatchSession(arg1) {
+  return window['go']['main']['App']['DeleteMatchSession'](arg1);
+}
+
 export function DeleteTransferRecord(arg1) {
   return window['go']['main']['App']['DeleteTransferRecord'](arg1);
 }
 
+export function DeleteWatchedPlaylist(arg1) {
+  return window['go']['main']['App']['DeleteWatchedPlaylist'](arg1);
+}
+
 export function DownloadTrack(arg1) {
   return window['go']['main']['App']['DownloadTrack'](arg1);
 }
 
+export function DryRunConversion(arg1, arg2) {
+  return window['go']['main']['App']['DryRunConversion'](arg1, arg2);
+}
+
+export function GetActiveDABMirror() {
+  return window['go']['main']['App']['GetActiveDABMirror']();
+}
+
 export function GetAlbumByID(arg1) {
   return window['go']['main']['App']['GetAlbumByID'](arg1);
 }
@@ -62,14 +102,34 @@ export function GetAppVersion() {
   return window['go']['main']['App']['GetAppVersion']();
 }
 
+export function GetArtist(arg1) {
+  return window['go']['main']['App']['GetArtist'](arg1);
+}
+
+export function GetArtistDiscography(arg1) {
+  return window['go']['main']['App']['GetArtistDiscography'](arg1);
+}
+
 export function GetConfig() {
   return window['go']['main']['App']['GetConfig']();
 }
 
+export function GetConversionCheckpoint(arg1) {
+  return window['go']['main']['App']['GetConversionCheckpoint'](arg1);
+}
+
+export function GetConversionCheckpoints() {
+  return window['go']['main']['App']['GetConversionCheckpoints']();
+}
+
 export function GetCurrentUser() {
   return window['go']['main']['App']['GetCurrentUser']();
 }
 
+export function GetDABMirrors() {
+  return window['go']['main']['App']['GetDABMirrors']();
+}
+
 export function GetDownloadHistory() {
   return window['go']['main']['App']['GetDownloadHistory']();
 }
@@ -94,6 +154,18 @@ export function GetLyrics(arg1, arg2) {
   return window['go']['main']['App']['GetLyrics'](arg1, arg2);
 }
 
+export function GetMatchCache() {
+  return window['go']['main']['App']['GetMatchCache']();
+}
+
+export function GetMatchSession(arg1) {
+  return window['go']['main']['App']['GetMatchSession'](arg1);
+}
+
+export function GetMatchSessions() {
+  return window['go']['main']['App']['GetMatchSessions']();
+}
+
 export function GetQueue() {
   return window['go']['main']['App']['GetQueue']();
 }
@@ -114,6 +186,14 @@ export function GetTransferHistory() {
   return window['go']['main']['App']['GetTransferHistory']();
 }
 
+export function GetVersionPolicy() {
+  return window['go']['main']['App']['GetVersionPolicy']();
+}
+
+export function GetWatchedPlaylists() {
+  return window['go']['main']['App']['GetWatchedPlaylists']();
+}
+
 export function Greet(arg1) {
   return window['go']['main']['App']['Greet'](arg1);
 }
@@ -150,6 +230,18 @@ export function RemoveFromLibrary(arg1, arg2) {
   return window['go']['main']['App']['RemoveFromLibrary'](arg1, arg2);
 }
 
+export function ResumeConversion(arg1, arg2) {
+  return window['go']['main']['App']['ResumeConversion'](arg1, arg2);
+}
+
+export function RetryFailedTracks(arg1, arg2) {
+  return window['go']['main']['App']['RetryFailedTracks'](arg1, arg2);
+}
+
+export function RunWatchedPlaylist(arg1) {
+  return window['go']['main']['App']['RunWatchedPlaylist'](arg1);
+}
+
 export function SaveConfig(arg1, arg2) {
   return window['go']['main']['App']['SaveConfig'](arg1, arg2);
 }
@@ -162,6 +254,22 @@ export function SaveQueue(arg1) {
   return window['go']['main']['App']['SaveQueue'](arg1);
 }
 
+export function SaveTLSSettings(arg1, arg2, arg3) {
+  return window['go']['main']['App']['SaveTLSSettings'](arg1, arg2, arg3);
+}
+
+export function SaveVersionPolicy(arg1) {
+  return window['go']['main']['App']['SaveVersionPolicy'](arg1);
+}
+
+export function SaveWatchedPlaylist(arg1) {
+  return window['go']['main']['App']['SaveWatchedPlaylist'](arg1);
+}
+
+export function SearchAdvanced(arg1, arg2) {
+  return window['go']['main']['App']['SearchAdvanced'](arg1, arg2);
+}
+
 export function SearchDAB(arg1) {
   return window['go']['main']['App']['SearchDAB'](arg1);
 }
@@ -170,10 +278,42 @@ export function SetDABAPIBase(arg1) {
   return window['go']['main']['App']['SetDABAPIBase'](arg1);
 }
 
+export function SetDABAPIBases(arg1) {
+  return window['go']['main']['App']['SetDABAPIBases'](arg1);
+}
+
+export function SetDABRateLimit(arg1) {
+  return window['go']['main']['App']['SetDABRateLimit'](arg1);
+}
+
+export function SetLyricsOffset(arg1, arg2, arg3) {
+  return window['go']['main']['App']['SetLyricsOffset'](arg1, arg2, arg3);
+}
+
+export function SetMatchDurationTolerance(arg1) {
+  return window['go']['main']['App']['SetMatchDurationTolerance'](arg1);
+}
+
 export function SpotifyLogin() {
   return window['go']['main']['App']['SpotifyLogin']();
 }
 
+export function StartMatchSession(arg1, arg2, arg3, arg4, arg5) {
+  return window['go']['main']['App']['StartMatchSession'](arg1, arg2, arg3, arg4, arg5);
+}
+
+export function StreamLibraryTracks(arg1, arg2) {
+  return window['go']['main']['App']['StreamLibraryTracks'](arg1, arg2);
+}
+
+export function SyncLibrary(arg1, arg2, arg3, arg4) {
+  return window['go']['main']['App']['SyncLibrary'](arg1, arg2, arg3, arg4);
+}
+
 export function UpdateLibrary(arg1, arg2, arg3, arg4) {
   return window['go']['main']['App']['UpdateLibrary'](arg1, arg2, arg3, arg4);
 }
+
+export function UpdateMatchSelection(arg1, arg2) {
+  return window['go']['main']['App']['UpdateMatchSelection'](arg1, arg2);
+}
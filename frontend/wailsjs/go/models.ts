@@ -16,43 +16,89 @@ export namespace services {
 	        this.isHiRes = source["isHiRes"];
 	    }
 	}
-	export class Config {
-	    SPOTIFY_CLIENT_ID: string;
-	    SPOTIFY_CLIENT_SECRET: string;
-	    SPOTIFY_REDIRECT_URI: string;
-	    DAB_API_BASE: string;
-	    DAB_AUTH_TOKEN?: string;
-	    DAB_EMAIL?: string;
-	    DAB_PASSWORD?: string;
-	    FUZZY_MATCH_SCALE: number;
-	    MAX_CONCURRENCY: number;
-	    SPOTIFY_ACCESS_TOKEN?: string;
-	    SPOTIFY_REFRESH_TOKEN?: string;
-	    SPOTIFY_TOKEN_EXPIRY?: string;
-	    DOWNLOAD_PATH: string;
-	    MAX_CACHE_SIZE: number;
+	export class CheckpointSummary {
+	    id: string;
+	    name: string;
+	    libraryId?: string;
+	    status: string;
+	    createdAt: string;
+	    updatedAt: string;
+	    totalTracks: number;
+	    matched: number;
+	    added: number;
+	    failed: number;
 	
 	    static createFrom(source: any = {}) {
-	        return new Config(source);
+	        return new CheckpointSummary(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.SPOTIFY_CLIENT_ID = source["SPOTIFY_CLIENT_ID"];
-	        this.SPOTIFY_CLIENT_SECRET = source["SPOTIFY_CLIENT_SECRET"];
-	        this.SPOTIFY_REDIRECT_URI = source["SPOTIFY_REDIRECT_URI"];
-	        this.DAB_API_BASE = source["DAB_API_BASE"];
-	        this.DAB_AUTH_TOKEN = source["DAB_AUTH_TOKEN"];
-	        this.DAB_EMAIL = source["DAB_EMAIL"];
-	        this.DAB_PASSWORD = source["DAB_PASSWORD"];
-	        this.FUZZY_MATCH_SCALE = source["FUZZY_MATCH_SCALE"];
-	        this.MAX_CONCURRENCY = source["MAX_CONCURRENCY"];
-	        this.SPOTIFY_ACCESS_TOKEN = source["SPOTIFY_ACCESS_TOKEN"];
-	        this.SPOTIFY_REFRESH_TOKEN = source["SPOTIFY_REFRESH_TOKEN"];
-	        this.SPOTIFY_TOKEN_EXPIRY = source["SPOTIFY_TOKEN_EXPIRY"];
-	        this.DOWNLOAD_PATH = source["DOWNLOAD_PATH"];
-	        this.MAX_CACHE_SIZE = source["MAX_CACHE_SIZE"];
+	        this.id = source["id"];
+	        this.name = source["name"];
+	        this.libraryId = source["libraryId"];
+	        this.status = source["status"];
+	        this.createdAt = source["createdAt"];
+	        this.updatedAt = source["updatedAt"];
+	        this.totalTracks = source["totalTracks"];
+	        this.matched = source["matched"];
+	        this.added = source["added"];
+	        this.failed = source["failed"];
+	    }
+	}
+	export class SignalScore {
+	    name: string;
+	    score: number;
+	    weight: number;
+	    available: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new SignalScore(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.name = source["name"];
+	        this.score = source["score"];
+	        this.weight = source["weight"];
+	        this.available = source["available"];
+	    }
+	}
+	export class ScoredCandidate {
+	    track: DABTrack;
+	    score: number;
+	    exactISRC: boolean;
+	    signals: SignalScore[];
+	
+	    static createFrom(source: any = {}) {
+	        return new ScoredCandidate(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.track = this.convertValues(source["track"], DABTrack);
+	        this.score = source["score"];
+	        this.exactISRC = source["exactISRC"];
+	        this.signals = this.convertValues(source["signals"], SignalScore);
 	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
 	}
 	export class DABTrack {
 	    id: any;
@@ -66,6 +112,10 @@ export namespace services {
 	    genre: string;
 	    duration: any;
 	    audioQuality: AudioQuality;
+	    trackNumber?: number;
+	    discNumber?: number;
+	    isrc?: string;
+	    parentalWarning?: boolean;
 	
 	    static createFrom(source: any = {}) {
 	        return new DABTrack(source);
@@ -84,6 +134,10 @@ export namespace services {
 	        this.genre = source["genre"];
 	        this.duration = source["duration"];
 	        this.audioQuality = this.convertValues(source["audioQuality"], AudioQuality);
+	        this.trackNumber = source["trackNumber"];
+	        this.discNumber = source["discNumber"];
+	        this.isrc = source["isrc"];
+	        this.parentalWarning = source["parentalWarning"];
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -104,74 +158,34 @@ export namespace services {
 		    return a;
 		}
 	}
-	export class DownloadItem {
-	    id: string;
-	    trackId: string;
-	    title: string;
-	    artist: string;
-	    album: string;
-	    coverArt: string;
+	export class TrackMatch {
+	    index: number;
+	    source: TrackInfo;
 	    status: string;
-	    progress: number;
-	    error: string;
-	    filePath: string;
-	    totalSize: number;
-	    downloaded: number;
+	    track?: DABTrack;
+	    score: number;
+	    via?: string;
+	    query?: string;
+	    breakdown?: string;
+	    candidates: ScoredCandidate[];
+	    error?: string;
 	
 	    static createFrom(source: any = {}) {
-	        return new DownloadItem(source);
+	        return new TrackMatch(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.id = source["id"];
-	        this.trackId = source["trackId"];
-	        this.title = source["title"];
-	        this.artist = source["artist"];
-	        this.album = source["album"];
-	        this.coverArt = source["coverArt"];
+	        this.index = source["index"];
+	        this.source = this.convertValues(source["source"], TrackInfo);
 	        this.status = source["status"];
-	        this.progress = source["progress"];
+	        this.track = this.convertValues(source["track"], DABTrack);
+	        this.score = source["score"];
+	        this.via = source["via"];
+	        this.query = source["query"];
+	        this.breakdown = source["breakdown"];
+	        this.candidates = this.convertValues(source["candidates"], ScoredCandidate);
 	        this.error = source["error"];
-	        this.filePath = source["filePath"];
-	        this.totalSize = source["totalSize"];
-	        this.downloaded = source["downloaded"];
-	    }
-	}
-	export class Library {
-	    id: string;
-	    name: string;
-	    trackCount: number;
-	
-	    static createFrom(source: any = {}) {
-	        return new Library(source);
-	    }
-	
-	    constructor(source: any = {}) {
-	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.id = source["id"];
-	        this.name = source["name"];
-	        this.trackCount = source["trackCount"];
-	    }
-	}
-	export class LibraryDetailsResponse {
-	    id: string;
-	    name: string;
-	    description: string;
-	    isPublic: boolean;
-	    tracks: DABTrack[];
-	
-	    static createFrom(source: any = {}) {
-	        return new LibraryDetailsResponse(source);
-	    }
-	
-	    constructor(source: any = {}) {
-	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.id = source["id"];
-	        this.name = source["name"];
-	        this.description = source["description"];
-	        this.isPublic = source["isPublic"];
-	        this.tracks = this.convertValues(source["tracks"], DABTrack);
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -203,6 +217,12 @@ export namespace services {
 	    album_cover: string;
 	    release_date: string;
 	    genre: string;
+	    explicit: boolean;
+	    disc_number?: number;
+	    track_number?: number;
+	    album_artist?: string;
+	    album_upc?: string;
+	    album_tracks?: number;
 	
 	    static createFrom(source: any = {}) {
 	        return new TrackInfo(source);
@@ -220,22 +240,30 @@ export namespace services {
 	        this.album_cover = source["album_cover"];
 	        this.release_date = source["release_date"];
 	        this.genre = source["genre"];
+	        this.explicit = source["explicit"];
+	        this.disc_number = source["disc_number"];
+	        this.track_number = source["track_number"];
+	        this.album_artist = source["album_artist"];
+	        this.album_upc = source["album_upc"];
+	        this.album_tracks = source["album_tracks"];
 	    }
 	}
-	export class PlaylistInfo {
-	    name: string;
-	    description: string;
-	    tracks: TrackInfo[];
+	export class CheckpointTrack {
+	    source: TrackInfo;
+	    match?: TrackMatch;
+	    addStatus?: string;
+	    error?: string;
 	
 	    static createFrom(source: any = {}) {
-	        return new PlaylistInfo(source);
+	        return new CheckpointTrack(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.name = source["name"];
-	        this.description = source["description"];
-	        this.tracks = this.convertValues(source["tracks"], TrackInfo);
+	        this.source = this.convertValues(source["source"], TrackInfo);
+	        this.match = this.convertValues(source["match"], TrackMatch);
+	        this.addStatus = source["addStatus"];
+	        this.error = source["error"];
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -256,50 +284,115 @@ export namespace services {
 		    return a;
 		}
 	}
+	export class Config {
+	    SPOTIFY_CLIENT_ID: string;
+	    SPOTIFY_CLIENT_SECRET: string;
+	    SPOTIFY_REDIRECT_URI: string;
+	    DAB_API_BASE: string;
+	    DAB_API_BASES?: string[];
+	    DAB_AUTH_TOKEN?: string;
+	    DAB_AUTH_BASE?: string;
+	    DAB_EMAIL?: string;
+	    DAB_PASSWORD?: string;
+	    FUZZY_MATCH_SCALE: number;
+	    MAX_CONCURRENCY: number;
+	    SPOTIFY_ACCESS_TOKEN?: string;
+	    SPOTIFY_REFRESH_TOKEN?: string;
+	    SPOTIFY_TOKEN_EXPIRY?: string;
+	    DOWNLOAD_PATH: string;
+	    MAX_CACHE_SIZE: number;
+	    DAB_REQUESTS_PER_SECOND: number;
+	    DAB_TLS_MODE: string;
+	    DAB_CA_CERT_PATH?: string;
+	    DAB_PINNED_SPKI?: string[];
+	    MATCH_DURATION_TOLERANCE: number;
+	    PREFER_EXPLICIT: string;
+	    PREFER_EDITION: string;
+	    PREFER_PERFORMANCE: string;
+	    PREFER_QUALITY: string;
+	    VERSION_TIE_MARGIN?: number;
 	
-	export class TransferRecord {
-	    id: string;
-	    playlistName: string;
-	    sourceURL: string;
-	    totalTracks: number;
-	    matchedTracks: number;
-	    addedTracks: number;
-	    failedTracks: number;
+	    static createFrom(source: any = {}) {
+	        return new Config(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.SPOTIFY_CLIENT_ID = source["SPOTIFY_CLIENT_ID"];
+	        this.SPOTIFY_CLIENT_SECRET = source["SPOTIFY_CLIENT_SECRET"];
+	        this.SPOTIFY_REDIRECT_URI = source["SPOTIFY_REDIRECT_URI"];
+	        this.DAB_API_BASE = source["DAB_API_BASE"];
+	        this.DAB_API_BASES = source["DAB_API_BASES"];
+	        this.DAB_AUTH_TOKEN = source["DAB_AUTH_TOKEN"];
+	        this.DAB_AUTH_BASE = source["DAB_AUTH_BASE"];
+	        this.DAB_EMAIL = source["DAB_EMAIL"];
+	        this.DAB_PASSWORD = source["DAB_PASSWORD"];
+	        this.FUZZY_MATCH_SCALE = source["FUZZY_MATCH_SCALE"];
+	        this.MAX_CONCURRENCY = source["MAX_CONCURRENCY"];
+	        this.SPOTIFY_ACCESS_TOKEN = source["SPOTIFY_ACCESS_TOKEN"];
+	        this.SPOTIFY_REFRESH_TOKEN = source["SPOTIFY_REFRESH_TOKEN"];
+	        this.SPOTIFY_TOKEN_EXPIRY = source["SPOTIFY_TOKEN_EXPIRY"];
+	        this.DOWNLOAD_PATH = source["DOWNLOAD_PATH"];
+	        this.MAX_CACHE_SIZE = source["MAX_CACHE_SIZE"];
+	        this.DAB_REQUESTS_PER_SECOND = source["DAB_REQUESTS_PER_SECOND"];
+	        this.DAB_TLS_MODE = source["DAB_TLS_MODE"];
+	        this.DAB_CA_CERT_PATH = source["DAB_CA_CERT_PATH"];
+	        this.DAB_PINNED_SPKI = source["DAB_PINNED_SPKI"];
+	        this.MATCH_DURATION_TOLERANCE = source["MATCH_DURATION_TOLERANCE"];
+	        this.PREFER_EXPLICIT = source["PREFER_EXPLICIT"];
+	        this.PREFER_EDITION = source["PREFER_EDITION"];
+	        this.PREFER_PERFORMANCE = source["PREFER_PERFORMANCE"];
+	        this.PREFER_QUALITY = source["PREFER_QUALITY"];
+	        this.VERSION_TIE_MARGIN = source["VERSION_TIE_MARGIN"];
+	    }
+	}
+	export class TransferTrack {
+	    source: TrackInfo;
+	    trackId?: string;
+	    score: number;
 	    status: string;
-	    createdAt: string;
-	    completedAt: string;
-	    libraryID: string;
-	    errorMessage?: string;
-	    duration: number;
-	    source: string;
+	    error?: string;
 	
 	    static createFrom(source: any = {}) {
-	        return new TransferRecord(source);
+	        return new TransferTrack(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.id = source["id"];
-	        this.playlistName = source["playlistName"];
-	        this.sourceURL = source["sourceURL"];
-	        this.totalTracks = source["totalTracks"];
-	        this.matchedTracks = source["matchedTracks"];
-	        this.addedTracks = source["addedTracks"];
-	        this.failedTracks = source["failedTracks"];
+	        this.source = this.convertValues(source["source"], TrackInfo);
+	        this.trackId = source["trackId"];
+	        this.score = source["score"];
 	        this.status = source["status"];
-	        this.createdAt = source["createdAt"];
-	        this.completedAt = source["completedAt"];
-	        this.libraryID = source["libraryID"];
-	        this.errorMessage = source["errorMessage"];
-	        this.duration = source["duration"];
-	        this.source = source["source"];
+	        this.error = source["error"];
 	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
 	}
 	export class TransferStats {
 	    total: number;
 	    matched: number;
 	    added: number;
 	    failed: number;
+	    cancelled: number;
+	    status?: string;
+	    libraryId?: string;
+	    tracks?: TransferTrack[];
 	
 	    static createFrom(source: any = {}) {
 	        return new TransferStats(source);
@@ -311,6 +404,877 @@ export namespace services {
 	        this.matched = source["matched"];
 	        this.added = source["added"];
 	        this.failed = source["failed"];
+	        this.cancelled = source["cancelled"];
+	        this.status = source["status"];
+	        this.libraryId = source["libraryId"];
+	        this.tracks = this.convertValues(source["tracks"], TransferTrack);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class ConversionCheckpoint {
+	    id: string;
+	    name: string;
+	    description: string;
+	    libraryId?: string;
+	    historyId?: string;
+	    sourceUrl?: string;
+	    status: string;
+	    createdAt: string;
+	    updatedAt: string;
+	    tracks: CheckpointTrack[];
+	    stats?: TransferStats;
+	
+	    static createFrom(source: any = {}) {
+	        return new ConversionCheckpoint(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.name = source["name"];
+	        this.description = source["description"];
+	        this.libraryId = source["libraryId"];
+	        this.historyId = source["historyId"];
+	        this.sourceUrl = source["sourceUrl"];
+	        this.status = source["status"];
+	        this.createdAt = source["createdAt"];
+	        this.updatedAt = source["updatedAt"];
+	        this.tracks = this.convertValues(source["tracks"], CheckpointTrack);
+	        this.stats = this.convertValues(source["stats"], TransferStats);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class DABAlbum {
+	    id: any;
+	    title: string;
+	    artist: string;
+	    artistId: any;
+	    cover: string;
+	    releaseDate: string;
+	    genre: string;
+	    label: string;
+	    upc: string;
+	    trackCount: number;
+	    discCount: number;
+	    audioQuality: AudioQuality;
+	    tracks: DABTrack[];
+	
+	    static createFrom(source: any = {}) {
+	        return new DABAlbum(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.title = source["title"];
+	        this.artist = source["artist"];
+	        this.artistId = source["artistId"];
+	        this.cover = source["cover"];
+	        this.releaseDate = source["releaseDate"];
+	        this.genre = source["genre"];
+	        this.label = source["label"];
+	        this.upc = source["upc"];
+	        this.trackCount = source["trackCount"];
+	        this.discCount = source["discCount"];
+	        this.audioQuality = this.convertValues(source["audioQuality"], AudioQuality);
+	        this.tracks = this.convertValues(source["tracks"], DABTrack);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class DABArtist {
+	    id: any;
+	    name: string;
+	    picture: string;
+	    bio?: string;
+	    albumCount: number;
+	    albums: DABAlbum[];
+	
+	    static createFrom(source: any = {}) {
+	        return new DABArtist(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.name = source["name"];
+	        this.picture = source["picture"];
+	        this.bio = source["bio"];
+	        this.albumCount = source["albumCount"];
+	        this.albums = this.convertValues(source["albums"], DABAlbum);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class DABMirror {
+	    base: string;
+	    healthy: boolean;
+	    active: boolean;
+	    latencyMs: number;
+	    lastError?: string;
+	    checkedAt?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new DABMirror(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.base = source["base"];
+	        this.healthy = source["healthy"];
+	        this.active = source["active"];
+	        this.latencyMs = source["latencyMs"];
+	        this.lastError = source["lastError"];
+	        this.checkedAt = source["checkedAt"];
+	    }
+	}
+	
+	export class DownloadItem {
+	    id: string;
+	    trackId: string;
+	    title: string;
+	    artist: string;
+	    album: string;
+	    coverArt: string;
+	    status: string;
+	    progress: number;
+	    error: string;
+	    filePath: string;
+	    totalSize: number;
+	    downloaded: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new DownloadItem(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.trackId = source["trackId"];
+	        this.title = source["title"];
+	        this.artist = source["artist"];
+	        this.album = source["album"];
+	        this.coverArt = source["coverArt"];
+	        this.status = source["status"];
+	        this.progress = source["progress"];
+	        this.error = source["error"];
+	        this.filePath = source["filePath"];
+	        this.totalSize = source["totalSize"];
+	        this.downloaded = source["downloaded"];
+	    }
+	}
+	export class DryRunTrack {
+	    index: number;
+	    source: TrackInfo;
+	    status: string;
+	    best?: DABTrack;
+	    score: number;
+	    matched: boolean;
+	    query?: string;
+	    via?: string;
+	    breakdown?: string;
+	    error?: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new DryRunTrack(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.index = source["index"];
+	        this.source = this.convertValues(source["source"], TrackInfo);
+	        this.status = source["status"];
+	        this.best = this.convertValues(source["best"], DABTrack);
+	        this.score = source["score"];
+	        this.matched = source["matched"];
+	        this.query = source["query"];
+	        this.via = source["via"];
+	        this.breakdown = source["breakdown"];
+	        this.error = source["error"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class DryRunReport {
+	    total: number;
+	    matched: number;
+	    review: number;
+	    notFound: number;
+	    failed: number;
+	    cancelled: number;
+	    matchRate: number;
+	    // Go type: time
+	    generatedAt: any;
+	    tracks: DryRunTrack[];
+	
+	    static createFrom(source: any = {}) {
+	        return new DryRunReport(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.total = source["total"];
+	        this.matched = source["matched"];
+	        this.review = source["review"];
+	        this.notFound = source["notFound"];
+	        this.failed = source["failed"];
+	        this.cancelled = source["cancelled"];
+	        this.matchRate = source["matchRate"];
+	        this.generatedAt = this.convertValues(source["generatedAt"], null);
+	        this.tracks = this.convertValues(source["tracks"], DryRunTrack);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	
+	export class Library {
+	    id: string;
+	    name: string;
+	    trackCount: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new Library(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.name = source["name"];
+	        this.trackCount = source["trackCount"];
+	    }
+	}
+	export class LibraryDetailsResponse {
+	    id: string;
+	    name: string;
+	    description: string;
+	    isPublic: boolean;
+	    tracks: DABTrack[];
+	
+	    static createFrom(source: any = {}) {
+	        return new LibraryDetailsResponse(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.name = source["name"];
+	        this.description = source["description"];
+	        this.isPublic = source["isPublic"];
+	        this.tracks = this.convertValues(source["tracks"], DABTrack);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class LyricLine {
+	    timeMs: number;
+	    text: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new LyricLine(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.timeMs = source["timeMs"];
+	        this.text = source["text"];
+	    }
+	}
+	export class Lyrics {
+	    artist: string;
+	    title: string;
+	    synced: boolean;
+	    lines: LyricLine[];
+	    plain: string;
+	    tagOffsetMs: number;
+	    offsetMs: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new Lyrics(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.artist = source["artist"];
+	        this.title = source["title"];
+	        this.synced = source["synced"];
+	        this.lines = this.convertValues(source["lines"], LyricLine);
+	        this.plain = source["plain"];
+	        this.tagOffsetMs = source["tagOffsetMs"];
+	        this.offsetMs = source["offsetMs"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class MatchEntry {
+	    key: string;
+	    sourceTitle: string;
+	    sourceArtist: string;
+	    trackId: string;
+	    track: DABTrack;
+	    score: number;
+	    manual: boolean;
+	    updatedAt: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new MatchEntry(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.key = source["key"];
+	        this.sourceTitle = source["sourceTitle"];
+	        this.sourceArtist = source["sourceArtist"];
+	        this.trackId = source["trackId"];
+	        this.track = this.convertValues(source["track"], DABTrack);
+	        this.score = source["score"];
+	        this.manual = source["manual"];
+	        this.updatedAt = source["updatedAt"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class MatchSelection {
+	    index: number;
+	    track?: DABTrack;
+	    skip: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new MatchSelection(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.index = source["index"];
+	        this.track = this.convertValues(source["track"], DABTrack);
+	        this.skip = source["skip"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class MatchSession {
+	    id: string;
+	    name: string;
+	    description: string;
+	    sourceURL?: string;
+	    status: string;
+	    checkpointId?: string;
+	    libraryId?: string;
+	    createdAt: string;
+	    updatedAt: string;
+	    tracks: TrackMatch[];
+	    stats?: TransferStats;
+	
+	    static createFrom(source: any = {}) {
+	        return new MatchSession(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.name = source["name"];
+	        this.description = source["description"];
+	        this.sourceURL = source["sourceURL"];
+	        this.status = source["status"];
+	        this.checkpointId = source["checkpointId"];
+	        this.libraryId = source["libraryId"];
+	        this.createdAt = source["createdAt"];
+	        this.updatedAt = source["updatedAt"];
+	        this.tracks = this.convertValues(source["tracks"], TrackMatch);
+	        this.stats = this.convertValues(source["stats"], TransferStats);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class MatchSessionSummary {
+	    id: string;
+	    name: string;
+	    status: string;
+	    createdAt: string;
+	    updatedAt: string;
+	    totalTracks: number;
+	    matched: number;
+	    needsReview: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new MatchSessionSummary(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.name = source["name"];
+	        this.status = source["status"];
+	        this.createdAt = source["createdAt"];
+	        this.updatedAt = source["updatedAt"];
+	        this.totalTracks = source["totalTracks"];
+	        this.matched = source["matched"];
+	        this.needsReview = source["needsReview"];
+	    }
+	}
+	export class PlaylistInfo {
+	    name: string;
+	    description: string;
+	    tracks: TrackInfo[];
+	
+	    static createFrom(source: any = {}) {
+	        return new PlaylistInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.name = source["name"];
+	        this.description = source["description"];
+	        this.tracks = this.convertValues(source["tracks"], TrackInfo);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	
+	export class SearchFilters {
+	    type: string;
+	    offset: number;
+	    limit: number;
+	    hiResOnly: boolean;
+	    yearFrom: number;
+	    yearTo: number;
+	    genre: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new SearchFilters(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.type = source["type"];
+	        this.offset = source["offset"];
+	        this.limit = source["limit"];
+	        this.hiResOnly = source["hiResOnly"];
+	        this.yearFrom = source["yearFrom"];
+	        this.yearTo = source["yearTo"];
+	        this.genre = source["genre"];
+	    }
+	}
+	export class SearchResult {
+	    query: string;
+	    type: string;
+	    offset: number;
+	    limit: number;
+	    total: number;
+	    hasMore: boolean;
+	    tracks: DABTrack[];
+	    albums: DABAlbum[];
+	    artists: DABArtist[];
+	    nextOffset?: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new SearchResult(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.query = source["query"];
+	        this.type = source["type"];
+	        this.offset = source["offset"];
+	        this.limit = source["limit"];
+	        this.total = source["total"];
+	        this.hasMore = source["hasMore"];
+	        this.tracks = this.convertValues(source["tracks"], DABTrack);
+	        this.albums = this.convertValues(source["albums"], DABAlbum);
+	        this.artists = this.convertValues(source["artists"], DABArtist);
+	        this.nextOffset = source["nextOffset"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	
+	export class SyncResult {
+	    libraryId: string;
+	    libraryName: string;
+	    sourceTotal: number;
+	    libraryTotal: number;
+	    unchanged: number;
+	    matched: number;
+	    added: number;
+	    removed: number;
+	    failed: number;
+	    cancelled: number;
+	    addedTracks: DABTrack[];
+	    removedTracks: DABTrack[];
+	    unmatchedTracks: TrackInfo[];
+	    tracks: TransferTrack[];
+	
+	    static createFrom(source: any = {}) {
+	        return new SyncResult(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.libraryId = source["libraryId"];
+	        this.libraryName = source["libraryName"];
+	        this.sourceTotal = source["sourceTotal"];
+	        this.libraryTotal = source["libraryTotal"];
+	        this.unchanged = source["unchanged"];
+	        this.matched = source["matched"];
+	        this.added = source["added"];
+	        this.removed = source["removed"];
+	        this.failed = source["failed"];
+	        this.cancelled = source["cancelled"];
+	        this.addedTracks = this.convertValues(source["addedTracks"], DABTrack);
+	        this.removedTracks = this.convertValues(source["removedTracks"], DABTrack);
+	        this.unmatchedTracks = this.convertValues(source["unmatchedTracks"], TrackInfo);
+	        this.tracks = this.convertValues(source["tracks"], TransferTrack);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	
+	
+	export class TransferRecord {
+	    id: string;
+	    playlistName: string;
+	    sourceURL: string;
+	    totalTracks: number;
+	    matchedTracks: number;
+	    addedTracks: number;
+	    failedTracks: number;
+	    status: string;
+	    createdAt: string;
+	    completedAt: string;
+	    libraryID: string;
+	    errorMessage?: string;
+	    duration: number;
+	    source: string;
+	    mode?: string;
+	    removedTracks?: number;
+	    tracks?: TransferTrack[];
+	
+	    static createFrom(source: any = {}) {
+	        return new TransferRecord(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.playlistName = source["playlistName"];
+	        this.sourceURL = source["sourceURL"];
+	        this.totalTracks = source["totalTracks"];
+	        this.matchedTracks = source["matchedTracks"];
+	        this.addedTracks = source["addedTracks"];
+	        this.failedTracks = source["failedTracks"];
+	        this.status = source["status"];
+	        this.createdAt = source["createdAt"];
+	        this.completedAt = source["completedAt"];
+	        this.libraryID = source["libraryID"];
+	        this.errorMessage = source["errorMessage"];
+	        this.duration = source["duration"];
+	        this.source = source["source"];
+	        this.mode = source["mode"];
+	        this.removedTracks = source["removedTracks"];
+	        this.tracks = this.convertValues(source["tracks"], TransferTrack);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	
+	
+	export class VersionPolicy {
+	    explicit: string;
+	    edition: string;
+	    performance: string;
+	    quality: string;
+	    tieMargin: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new VersionPolicy(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.explicit = source["explicit"];
+	        this.edition = source["edition"];
+	        this.performance = source["performance"];
+	        this.quality = source["quality"];
+	        this.tieMargin = source["tieMargin"];
+	    }
+	}
+	export class WatchedPlaylist {
+	    id: string;
+	    name: string;
+	    sourceURL: string;
+	    libraryID: string;
+	    schedule: string;
+	    removeMissing: boolean;
+	    enabled: boolean;
+	    createdAt: string;
+	    lastRunAt?: string;
+	    nextRunAt?: string;
+	    lastStatus?: string;
+	    lastError?: string;
+	    lastAdded: number;
+	    lastRemoved: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new WatchedPlaylist(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.name = source["name"];
+	        this.sourceURL = source["sourceURL"];
+	        this.libraryID = source["libraryID"];
+	        this.schedule = source["schedule"];
+	        this.removeMissing = source["removeMissing"];
+	        this.enabled = source["enabled"];
+	        this.createdAt = source["createdAt"];
+	        this.lastRunAt = source["lastRunAt"];
+	        this.nextRunAt = source["nextRunAt"];
+	        this.lastStatus = source["lastStatus"];
+	        this.lastError = source["lastError"];
+	        this.lastAdded = source["lastAdded"];
+	        this.lastRemoved = source["lastRemoved"];
 	    }
 	}
 
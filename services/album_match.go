@@ -0,0 +1,168 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+const albumMatchThreshold = 80
+
+type AlbumRef struct {
+	Title      string `json:"title"`
+	Artist     string `json:"artist"`
+	UPC        string `json:"upc"`
+	TrackCount int    `json:"trackCount"`
+}
+
+func albumContext(tracks []TrackInfo) (AlbumRef, bool) {
+	if len(tracks) == 0 {
+		return AlbumRef{}, false
+	}
+	first := tracks[0]
+	ref := AlbumRef{
+		Title:      first.AlbumTitle,
+		Artist:     first.AlbumArtist,
+		UPC:        first.AlbumUPC,
+		TrackCount: first.AlbumTracks,
+	}
+	if ref.Title == "" || ref.TrackCount == 0 {
+		return AlbumRef{}, false
+	}
+	for _, t := range tracks {
+		if t.AlbumTitle != ref.Title || t.AlbumUPC != ref.UPC || t.TrackNumber == 0 {
+			return AlbumRef{}, false
+		}
+	}
+	if ref.Artist == "" {
+		ref.Artist = first.Artist
+	}
+	return ref, true
+}
+
+func normalizeUPC(upc string) string {
+	return strings.TrimLeft(strings.TrimSpace(upc), "0")
+}
+
+func (s *DABService) ResolveAlbumContext(ctx context.Context, ref AlbumRef) (*DABAlbum, int, error) {
+	var candidates []DABAlbum
+	if upc := normalizeUPC(ref.UPC); upc != "" {
+		if result, err := s.SearchPageContext(ctx, ref.UPC, SearchOptions{Type: SearchTypeAlbum}); err == nil {
+			for _, a := range result.Albums {
+				if normalizeUPC(a.UPC) == upc {
+					candidates = append(candidates, a)
+				}
+			}
+		} else if ctx.Err() != nil {
+			return nil, 0, ctx.Err()
+		}
+	}
+	if len(candidates) == 0 {
+		result, err := s.SearchPageContext(ctx, fmt.Sprintf("%s %s", ref.Artist, ref.Title), SearchOptions{Type: SearchTypeAlbum, Limit: 10})
+		if err != nil {
+			return nil, 0, err
+		}
+		candidates = result.Albums
+	}
+
+	var best *DABAlbum
+	bestScore := 0
+	for i := range candidates {
+		score := scoreAlbum(ref, candidates[i])
+		if score > bestScore {
+			bestScore = score
+			best = &candidates[i]
+		}
+	}
+	if best == nil || bestScore < albumMatchThreshold {
+		return nil, bestScore, fmt.Errorf("album %s - %s: %w", ref.Artist, ref.Title, ErrDABNotFound)
+	}
+
+	album, err := s.GetAlbumByIDContext(ctx, trackIDString(best.ID))
+	if err != nil {
+		return nil, bestScore, err
+	}
+	if ref.TrackCount > 0 && len(album.Tracks) > 0 && len(album.Tracks) != ref.TrackCount {
+		bestScore = scoreAlbum(ref, *album)
+		if bestScore < albumMatchThreshold {
+			return nil, bestScore, fmt.Errorf("album %s - %s: track count %d does not match %d", ref.Artist, ref.Title, len(album.Tracks), ref.TrackCount)
+		}
+	}
+	return album, bestScore, nil
+}
+
+func scoreAlbum(ref AlbumRef, a DABAlbum) int {
+	if upc := normalizeUPC(ref.UPC); upc != "" && normalizeUPC(a.UPC) == upc {
+		return 100
+	}
+
+	countScore := 100
+	count := a.TrackCount
+	if count == 0 {
+		count = len(a.Tracks)
+	}
+	if ref.TrackCount > 0 && count > 0 && count != ref.TrackCount {
+		diff := count - ref.TrackCount
+		if diff < 0 {
+			diff = -diff
+		}
+		countScore = 100 - 20*diff
+		if countScore < 0 {
+			countScore = 0
+		}
+	}
+
+	signals := []SignalScore{
+		{Name: SignalTitle, Score: calculateSimilarity(ref.Title, a.Title), Weight: 0.45, Available: true},
+		{Name: SignalArtist, Score: calculateSimilarity(ref.Artist, a.Artist), Weight: 0.35, Available: ref.Artist != "" && a.Artist != ""},
+		{Name: "tracks", Score: countScore, Weight: 0.20, Available: true},
+	}
+	return weightedScore(signals)
+}
+
+func (s *DABService) matchAlbumTracks(ctx context.Context, store *MatchStore, ref AlbumRef, tracks []TrackInfo, matches []TrackMatch, onProgress func(string), onTrackStatus func(int, string, string)) []int {
+	all := make([]int, len(tracks))
+	for i := range tracks {
+		all[i] = i
+	}
+
+	onProgress(fmt.Sprintf("Resolving album '%s' by %s on DAB...", ref.Title, ref.Artist))
+	album, score, err := s.ResolveAlbumContext(ctx, ref)
+	if err != nil {
+		if ctx.Err() == nil {
+			onProgress(fmt.Sprintf("ℹ Album not resolved (Best Score: %d%%): %v. Falling back to per-track search.", score, err))
+		}
+		return all
+	}
+	onProgress(fmt.Sprintf("✓ Album resolved: %s - %s (%d tracks, Score: %d%%)", album.Artist, album.Title, len(album.Tracks), score))
+
+	var pending []int
+	for i, t := range tracks {
+		disc := t.DiscNumber
+		if disc == 0 {
+			disc = 1
+		}
+		prefix := fmt.Sprintf("[%d/%d]", i+1, len(tracks))
+
+		dt, ok := album.Track(disc, t.TrackNumber)
+		if !ok {
+			pending = append(pending, i)
+			continue
+		}
+		res := s.matcher.Match(t, []DABTrack{*dt})
+		if !res.Matched {
+			onProgress(fmt.Sprintf("%s ⚠ Album position %d-%d is '%s' (Score: %d%%), searching instead", prefix, disc, t.TrackNumber, dt.Title, res.Score))
+			pending = append(pending, i)
+			continue
+		}
+
+		m := TrackMatch{Index: i, Source: t}
+		m.apply(res, "album")
+		m.Query = fmt.Sprintf("%s %s", ref.Artist, ref.Title)
+		matches[i] = m
+		store.Put(t, *res.Track, res.Score)
+		onProgress(fmt.Sprintf("%s ✓ Matched via album %d-%d: %s - %s (Score: %d%%) [%s]", prefix, disc, t.TrackNumber, res.Track.Artist, res.Track.Title, res.Score, res.Breakdown()))
+		onTrackStatus(i, "found", "")
+	}
+	return pending
+}
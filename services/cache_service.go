@@ -127,7 +127,7 @@ func (s *CacheService) GetStream(w http.ResponseWriter, r *http.Request) {
 	}
 	s.mu.RUnlock()
 
-	streamURL, err := s.dabService.GetStreamURL(trackID)
+	streamURL, err := s.dabService.GetStreamURLContext(r.Context(), trackID)
 	if err != nil {
 		http.Error(w, "failed to get stream url: "+err.Error(), http.StatusInternalServerError)
 		return
@@ -137,7 +137,15 @@ func (s *CacheService) GetStream(w http.ResponseWriter, r *http.Request) {
 	if method == "" {
 		method = "GET"
 	}
-	req, err := http.NewRequest(method, streamURL, nil)
+	// The stream URL usually points at a CDN or YouTube; only requests to a
+	// DAB host count against the DAB rate limit.
+	viaDAB := s.dabService.IsDABURL(streamURL)
+	if viaDAB {
+		if err := s.dabService.Limiter().Wait(r.Context()); err != nil {
+			return
+		}
+	}
+	req, err := http.NewRequestWithContext(r.Context(), method, streamURL, nil)
 	if err != nil {
 		http.Error(w, "failed to create request: "+err.Error(), http.StatusInternalServerError)
 		return
@@ -152,12 +160,11 @@ func (s *CacheService) GetStream(w http.ResponseWriter, r *http.Request) {
 		req.Header.Set("Origin", "https://music.youtube.com")
 	}
 
-	if strings.Contains(streamURL, resolveDABAPIBase(s.config)) && s.config.DABAuthToken != "" {
-		req.AddCookie(&http.Cookie{Name: "session", Value: s.config.DABAuthToken})
+	if token := s.dabService.TokenFor(streamURL); token != "" {
+		req.AddCookie(&http.Cookie{Name: "session", Value: token})
 	}
 
-	client := &http.Client{}
-	resp, err := client.Do(req)
+	resp, err := s.dabService.HTTPClient().Do(req)
 	if err != nil {
 		http.Error(w, "failed to fetch stream: "+err.Error(), http.StatusBadGateway)
 		return
@@ -165,6 +172,9 @@ func (s *CacheService) GetStream(w http.ResponseWriter, r *http.Request) {
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusPartialContent {
+		if viaDAB && resp.StatusCode == http.StatusTooManyRequests {
+			s.dabService.Limiter().Throttle(parseRetryAfter(resp.Header.Get("Retry-After")))
+		}
 		for k, v := range resp.Header {
 			w.Header()[k] = v
 		}
@@ -0,0 +1,456 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	CheckpointStatusMatching    = "matching"
+	CheckpointStatusAdding      = "adding"
+	CheckpointStatusInterrupted = "interrupted"
+	CheckpointStatusCompleted   = "completed"
+	CheckpointStatusFailed      = "failed"
+
+	AddStatusAdded  = "added"
+	AddStatusFailed = "failed"
+
+	checkpointSaveInterval = time.Second
+)
+
+type CheckpointTrack struct {
+	Source    TrackInfo   `json:"source"`
+	Match     *TrackMatch `json:"match,omitempty"`
+	AddStatus string      `json:"addStatus,omitempty"`
+	Error     string      `json:"error,omitempty"`
+}
+
+type ConversionCheckpoint struct {
+	ID          string            `json:"id"`
+	Name        string            `json:"name"`
+	Description string            `json:"description"`
+	LibraryID   string            `json:"libraryId,omitempty"`
+	HistoryID   string            `json:"historyId,omitempty"`
+	SourceURL   string            `json:"sourceUrl,omitempty"`
+	Status      string            `json:"status"`
+	CreatedAt   string            `json:"createdAt"`
+	UpdatedAt   string            `json:"updatedAt"`
+	Tracks      []CheckpointTrack `json:"tracks"`
+	Stats       *TransferStats    `json:"stats,omitempty"`
+}
+
+type TransferOrigin struct {
+	HistoryID string `json:"historyId"`
+	SourceURL string `json:"sourceUrl"`
+}
+
+type CheckpointSummary struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	LibraryID   string `json:"libraryId,omitempty"`
+	Status      string `json:"status"`
+	CreatedAt   string `json:"createdAt"`
+	UpdatedAt   string `json:"updatedAt"`
+	TotalTracks int    `json:"totalTracks"`
+	Matched     int    `json:"matched"`
+	Added       int    `json:"added"`
+	Failed      int    `json:"failed"`
+}
+
+func NewConversionCheckpoint(name, description string, tracks []TrackInfo, origin TransferOrigin) *ConversionCheckpoint {
+	cp := &ConversionCheckpoint{
+		Name:        name,
+		Description: description,
+		HistoryID:   origin.HistoryID,
+		SourceURL:   origin.SourceURL,
+		Status:      CheckpointStatusMatching,
+		Tracks:      make([]CheckpointTrack, len(tracks)),
+	}
+	for i, t := range tracks {
+		cp.Tracks[i].Source = t
+	}
+	return cp
+}
+
+func checkpointFromMatches(name, description string, matches []TrackMatch, origin TransferOrigin) *ConversionCheckpoint {
+	cp := &ConversionCheckpoint{
+		Name:        name,
+		Description: description,
+		HistoryID:   origin.HistoryID,
+		SourceURL:   origin.SourceURL,
+		Status:      CheckpointStatusAdding,
+		Tracks:      make([]CheckpointTrack, len(matches)),
+	}
+	for i, m := range matches {
+		m.Index = i
+		cp.Tracks[i].Source = m.Source
+		cp.setMatch(m)
+	}
+	return cp
+}
+
+func (cp *ConversionCheckpoint) setMatch(m TrackMatch) {
+	if m.Status == MatchStatusCancelled && m.Track == nil {
+		cp.Tracks[m.Index].Match = nil
+		return
+	}
+	m.Candidates = nil
+	cp.Tracks[m.Index].Match = &m
+}
+
+func (cp *ConversionCheckpoint) pendingMatches() []int {
+	var pending []int
+	for i, t := range cp.Tracks {
+		if t.Match == nil {
+			pending = append(pending, i)
+		}
+	}
+	return pending
+}
+
+func (cp *ConversionCheckpoint) outcomes() []TransferTrack {
+	out := make([]TransferTrack, len(cp.Tracks))
+	for i, t := range cp.Tracks {
+		if t.Match == nil {
+			out[i] = TransferTrack{Source: t.Source, Status: TrackOutcomeCancelled}
+			continue
+		}
+		out[i] = newTransferTrack(*t.Match)
+		switch t.AddStatus {
+		case AddStatusAdded:
+			out[i].Status = TrackOutcomeAdded
+		case AddStatusFailed:
+			out[i].Status = TrackOutcomeFailed
+			out[i].Error = t.Error
+		}
+	}
+	return out
+}
+
+func (cp *ConversionCheckpoint) Resumable() bool {
+	if cp.Status != CheckpointStatusCompleted {
+		return true
+	}
+	for _, t := range cp.Tracks {
+		if t.AddStatus == AddStatusFailed {
+			return true
+		}
+	}
+	return false
+}
+
+func (cp *ConversionCheckpoint) Summary() CheckpointSummary {
+	sum := CheckpointSummary{
+		ID:          cp.ID,
+		Name:        cp.Name,
+		LibraryID:   cp.LibraryID,
+		Status:      cp.Status,
+		CreatedAt:   cp.CreatedAt,
+		UpdatedAt:   cp.UpdatedAt,
+		TotalTracks: len(cp.Tracks),
+	}
+	for _, t := range cp.Tracks {
+		if t.Match != nil && t.Match.Track != nil {
+			sum.Matched++
+		}
+		switch t.AddStatus {
+		case AddStatusAdded:
+			sum.Added++
+		case AddStatusFailed:
+			sum.Failed++
+		}
+	}
+	return sum
+}
+
+type CheckpointStore struct {
+	mu  sync.Mutex
+	dir string
+}
+
+func NewCheckpointStore() (*CheckpointStore, error) {
+	dir, err := GetConfigDir()
+	if err != nil {
+		return nil, err
+	}
+	dir = filepath.Join(dir, "checkpoints")
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	return &CheckpointStore{dir: dir}, nil
+}
+
+func (st *CheckpointStore) path(id string) (string, error) {
+	if id == "" || strings.ContainsAny(id, `/\.`) {
+		return "", fmt.Errorf("invalid checkpoint id %q", id)
+	}
+	return filepath.Join(st.dir, id+".json"), nil
+}
+
+func (st *CheckpointStore) Save(cp *ConversionCheckpoint) error {
+	if st == nil {
+		return fmt.Errorf("checkpoint store unavailable")
+	}
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	if cp.ID == "" {
+		cp.ID = fmt.Sprintf("checkpoint_%d", time.Now().UnixMilli())
+	}
+	now := time.Now().Format(time.RFC3339)
+	if cp.CreatedAt == "" {
+		cp.CreatedAt = now
+	}
+	cp.UpdatedAt = now
+
+	path, err := st.path(cp.ID)
+	if err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(cp, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0644)
+}
+
+func (st *CheckpointStore) Load(id string) (*ConversionCheckpoint, error) {
+	if st == nil {
+		return nil, fmt.Errorf("checkpoint store unavailable")
+	}
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	path, err := st.path(id)
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("checkpoint %s not found", id)
+		}
+		return nil, err
+	}
+	var cp ConversionCheckpoint
+	if err := json.Unmarshal(data, &cp); err != nil {
+		return nil, err
+	}
+	return &cp, nil
+}
+
+func (st *CheckpointStore) List() ([]CheckpointSummary, error) {
+	if st == nil {
+		return []CheckpointSummary{}, nil
+	}
+	entries, err := os.ReadDir(st.dir)
+	if err != nil {
+		return nil, err
+	}
+
+	summaries := []CheckpointSummary{}
+	for _, e := range entries {
+		if e.IsDir() || filepath.Ext(e.Name()) != ".json" {
+			continue
+		}
+		cp, err := st.Load(strings.TrimSuffix(e.Name(), ".json"))
+		if err != nil {
+			continue
+		}
+		summaries = append(summaries, cp.Summary())
+	}
+	sort.Slice(summaries, func(i, j int) bool {
+		return summaries[i].UpdatedAt > summaries[j].UpdatedAt
+	})
+	return summaries, nil
+}
+
+func (st *CheckpointStore) Delete(id string) error {
+	if st == nil {
+		return fmt.Errorf("checkpoint store unavailable")
+	}
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	path, err := st.path(id)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+func (s *DABService) SetCheckpointStore(st *CheckpointStore) {
+	s.checkpoints = st
+}
+
+func (s *DABService) saveCheckpoint(cp *ConversionCheckpoint, onProgress func(string)) {
+	if s.checkpoints == nil {
+		return
+	}
+	if err := s.checkpoints.Save(cp); err != nil {
+		onProgress(fmt.Sprintf("⚠ Failed to save checkpoint: %v", err))
+	}
+}
+
+func (s *DABService) ResumeConversion(id string, onProgress func(string), onTrackStatus func(int, string, string)) (*TransferStats, error) {
+	return s.ResumeConversionContext(context.Background(), id, onProgress, onTrackStatus)
+}
+
+func (s *DABService) ResumeConversionContext(ctx context.Context, id string, onProgress func(string), onTrackStatus func(int, string, string)) (*TransferStats, error) {
+	cp, err := s.checkpoints.Load(id)
+	if err != nil {
+		return nil, err
+	}
+	if !cp.Resumable() {
+		return cp.Stats, fmt.Errorf("conversion %s is already complete", id)
+	}
+	onProgress(fmt.Sprintf("Resuming conversion '%s'...", cp.Name))
+	return s.runCheckpointContext(ctx, cp, onProgress, onTrackStatus)
+}
+
+func (s *DABService) runCheckpointContext(ctx context.Context, cp *ConversionCheckpoint, onProgress func(string), onTrackStatus func(int, string, string)) (*TransferStats, error) {
+	if s.AuthToken() == "" {
+		return nil, fmt.Errorf("not logged in to DAB")
+	}
+
+	if pending := cp.pendingMatches(); len(pending) > 0 {
+		cp.Status = CheckpointStatusMatching
+		s.saveCheckpoint(cp, onProgress)
+
+		sources := make([]TrackInfo, len(pending))
+		for i, idx := range pending {
+			sources[i] = cp.Tracks[idx].Source
+		}
+		matches := s.MatchTracksContext(ctx, sources, onProgress, func(i int, status, errorMsg string) {
+			onTrackStatus(pending[i], status, errorMsg)
+		})
+		for i, m := range matches {
+			m.Index = pending[i]
+			cp.setMatch(m)
+		}
+	}
+
+	stats := &TransferStats{Total: len(cp.Tracks)}
+	var selected []TrackMatch
+	alreadyAdded := 0
+	for i, t := range cp.Tracks {
+		switch {
+		case t.AddStatus == AddStatusAdded:
+			alreadyAdded++
+			onTrackStatus(i, "added", "")
+		case t.Match == nil:
+			stats.Cancelled++
+		case t.Match.Track != nil:
+			selected = append(selected, *t.Match)
+		}
+	}
+	stats.Matched = len(selected) + alreadyAdded
+
+	finish := func(status string) {
+		stats.LibraryID = cp.LibraryID
+		stats.Tracks = cp.outcomes()
+		cp.Status = status
+		cp.Stats = stats
+		if status == CheckpointStatusCompleted && !cp.Resumable() && s.checkpoints.Delete(cp.ID) == nil {
+			return
+		}
+		s.saveCheckpoint(cp, onProgress)
+	}
+
+	abortMatched := func() (*TransferStats, error) {
+		for _, m := range selected {
+			onTrackStatus(m.Index, "cancelled", "")
+		}
+		stats.Cancelled += len(selected)
+		stats.Added = alreadyAdded
+		stats.Failed = stats.Total - stats.Added - stats.Cancelled
+		finish(CheckpointStatusInterrupted)
+		onProgress("Conversion cancelled.")
+		return stats, ctx.Err()
+	}
+
+	if ctx.Err() != nil {
+		return abortMatched()
+	}
+
+	if stats.Matched == 0 {
+		onProgress("No tracks matched. Aborting library creation.")
+		stats.Failed = stats.Total - stats.Cancelled
+		finish(CheckpointStatusFailed)
+		return stats, fmt.Errorf("no tracks matched")
+	}
+
+	var members *LibraryMembership
+	if cp.LibraryID == "" {
+		onProgress(fmt.Sprintf("Creating library '%s' with %d tracks...", cp.Name, len(selected)))
+		libraryID, err := s.createLibraryEntity(ctx, cp.Name, cp.Description)
+		if err != nil {
+			if ctx.Err() != nil {
+				return abortMatched()
+			}
+			finish(CheckpointStatusInterrupted)
+			return stats, fmt.Errorf("failed to create library: %v", err)
+		}
+		cp.LibraryID = libraryID
+		members = NewLibraryMembership(nil)
+		onProgress("Library container created. Adding tracks...")
+	} else {
+		onProgress(fmt.Sprintf("Continuing library %s: %d tracks already added, %d remaining...", cp.LibraryID, alreadyAdded, len(selected)))
+		loaded, err := s.LoadLibraryMembership(ctx, cp.LibraryID)
+		if err != nil {
+			onProgress(fmt.Sprintf("⚠ Failed to load library contents, existing tracks will not be skipped: %v", err))
+		}
+		members = loaded
+	}
+	cp.Status = CheckpointStatusAdding
+	s.saveCheckpoint(cp, onProgress)
+
+	var mu sync.Mutex
+	lastSave := time.Now()
+	record := func(index int, status, errorMsg string) {
+		onTrackStatus(index, status, errorMsg)
+		if status != "added" && status != "error" {
+			return
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		t := &cp.Tracks[index]
+		if status == "added" {
+			t.AddStatus = AddStatusAdded
+			t.Error = ""
+		} else {
+			t.AddStatus = AddStatusFailed
+			t.Error = errorMsg
+		}
+		if time.Since(lastSave) >= checkpointSaveInterval {
+			s.saveCheckpoint(cp, onProgress)
+			lastSave = time.Now()
+		}
+	}
+
+	added := s.AddMatchesContext(ctx, cp.LibraryID, members, selected, onProgress, record)
+
+	stats.Added = alreadyAdded + added.Added
+	stats.Cancelled += added.Cancelled
+	stats.Failed = stats.Total - stats.Added - stats.Cancelled
+
+	if ctx.Err() != nil {
+		finish(CheckpointStatusInterrupted)
+		onProgress(fmt.Sprintf("Conversion cancelled. %d tracks added before stopping.", stats.Added))
+		return stats, ctx.Err()
+	}
+
+	finish(CheckpointStatusCompleted)
+	onProgress("Conversion complete!")
+	return stats, nil
+}
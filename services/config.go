@@ -9,20 +9,32 @@ import (
 )
 
 type Config struct {
-	SpotifyClientID     string `json:"SPOTIFY_CLIENT_ID"`
-	SpotifyClientSecret string `json:"SPOTIFY_CLIENT_SECRET"`
-	SpotifyRedirectURI  string `json:"SPOTIFY_REDIRECT_URI"`
-	DABAPIBase          string `json:"DAB_API_BASE"`
-	DABAuthToken        string `json:"DAB_AUTH_TOKEN,omitempty"`
-	DABEmail            string `json:"DAB_EMAIL,omitempty"`
-	DABPassword         string `json:"DAB_PASSWORD,omitempty"`
-	FuzzyMatchScale     int    `json:"FUZZY_MATCH_SCALE"`
-	MaxConcurrency      int    `json:"MAX_CONCURRENCY"`
-	SpotifyAccessToken  string `json:"SPOTIFY_ACCESS_TOKEN,omitempty"`
-	SpotifyRefreshToken string `json:"SPOTIFY_REFRESH_TOKEN,omitempty"`
-	SpotifyTokenExpiry  string `json:"SPOTIFY_TOKEN_EXPIRY,omitempty"`
-	DownloadPath        string `json:"DOWNLOAD_PATH"`
-	MaxCacheSize        int64  `json:"MAX_CACHE_SIZE"`
+	SpotifyClientID      string   `json:"SPOTIFY_CLIENT_ID"`
+	SpotifyClientSecret  string   `json:"SPOTIFY_CLIENT_SECRET"`
+	SpotifyRedirectURI   string   `json:"SPOTIFY_REDIRECT_URI"`
+	DABAPIBase           string   `json:"DAB_API_BASE"`
+	DABAPIBases          []string `json:"DAB_API_BASES,omitempty"`
+	DABAuthToken         string   `json:"DAB_AUTH_TOKEN,omitempty"`
+	DABAuthBase          string   `json:"DAB_AUTH_BASE,omitempty"`
+	DABEmail             string   `json:"DAB_EMAIL,omitempty"`
+	DABPassword          string   `json:"DAB_PASSWORD,omitempty"`
+	FuzzyMatchScale      int      `json:"FUZZY_MATCH_SCALE"`
+	MaxConcurrency       int      `json:"MAX_CONCURRENCY"`
+	SpotifyAccessToken   string   `json:"SPOTIFY_ACCESS_TOKEN,omitempty"`
+	SpotifyRefreshToken  string   `json:"SPOTIFY_REFRESH_TOKEN,omitempty"`
+	SpotifyTokenExpiry   string   `json:"SPOTIFY_TOKEN_EXPIRY,omitempty"`
+	DownloadPath         string   `json:"DOWNLOAD_PATH"`
+	MaxCacheSize         int64    `json:"MAX_CACHE_SIZE"`
+	DABRequestsPerSecond float64  `json:"DAB_REQUESTS_PER_SECOND"`
+	DABTLSMode           string   `json:"DAB_TLS_MODE"`
+	DABCACertPath        string   `json:"DAB_CA_CERT_PATH,omitempty"`
+	DABPinnedSPKI        []string `json:"DAB_PINNED_SPKI,omitempty"`
+	DurationTolerance    int      `json:"MATCH_DURATION_TOLERANCE"`
+	PreferExplicit       string   `json:"PREFER_EXPLICIT"`
+	PreferEdition        string   `json:"PREFER_EDITION"`
+	PreferPerformance    string   `json:"PREFER_PERFORMANCE"`
+	PreferQuality        string   `json:"PREFER_QUALITY"`
+	VersionTieMargin     *int     `json:"VERSION_TIE_MARGIN,omitempty"`
 }
 
 func GetConfigDir() (string, error) {
@@ -57,6 +69,10 @@ func dirExists(path string) bool {
 	return info.IsDir()
 }
 
+func intPtr(v int) *int {
+	return &v
+}
+
 func defaultDownloadPath() string {
 	home, err := os.UserHomeDir()
 	if err != nil || home == "" {
@@ -126,14 +142,22 @@ func LoadConfig() (*Config, error) {
 
 	if _, err := os.Stat(path); os.IsNotExist(err) {
 		cfg := &Config{
-			SpotifyClientID:     "",
-			SpotifyClientSecret: "",
-			SpotifyRedirectURI:  "http://127.0.0.1:8888/callback",
-			DABAPIBase:          "https://dabmusic.xyz/api",
-			FuzzyMatchScale:     85,
-			MaxConcurrency:      3,
-			DownloadPath:        defaultDownloadPath(),
-			MaxCacheSize:        1024 * 1024 * 1024,
+			SpotifyClientID:      "",
+			SpotifyClientSecret:  "",
+			SpotifyRedirectURI:   "http://127.0.0.1:8888/callback",
+			DABAPIBase:           defaultDABAPIBase,
+			FuzzyMatchScale:      85,
+			MaxConcurrency:       3,
+			DownloadPath:         defaultDownloadPath(),
+			MaxCacheSize:         1024 * 1024 * 1024,
+			DABRequestsPerSecond: defaultDABRequestsPerSecond,
+			DABTLSMode:           TLSModeVerify,
+			DurationTolerance:    defaultDurationTolerance,
+			PreferExplicit:       VersionPreferSource,
+			PreferEdition:        VersionPreferSource,
+			PreferPerformance:    VersionPreferSource,
+			PreferQuality:        VersionPreferHighest,
+			VersionTieMargin:     intPtr(defaultVersionTieMargin),
 		}
 		dotEnvBase := normalizeDABAPIBase(readDotEnvValue("BASE"))
 		if dotEnvBase == "" {
@@ -165,15 +189,40 @@ func LoadConfig() (*Config, error) {
 	if cfg.MaxCacheSize == 0 {
 		cfg.MaxCacheSize = 1024 * 1024 * 1024
 	}
+	if cfg.DABRequestsPerSecond <= 0 {
+		cfg.DABRequestsPerSecond = defaultDABRequestsPerSecond
+	}
+	if cfg.DABTLSMode == "" {
+		cfg.DABTLSMode = TLSModeVerify
+	}
+	if cfg.DurationTolerance <= 0 {
+		cfg.DurationTolerance = defaultDurationTolerance
+	}
+	if cfg.PreferExplicit == "" {
+		cfg.PreferExplicit = VersionPreferSource
+	}
+	if cfg.PreferEdition == "" {
+		cfg.PreferEdition = VersionPreferSource
+	}
+	if cfg.PreferPerformance == "" {
+		cfg.PreferPerformance = VersionPreferSource
+	}
+	if cfg.PreferQuality == "" {
+		cfg.PreferQuality = VersionPreferHighest
+	}
+	// A pointer so that an explicit margin of 0 (only exact ties) is kept.
+	if cfg.VersionTieMargin == nil {
+		cfg.VersionTieMargin = intPtr(defaultVersionTieMargin)
+	}
 	if cfg.DABAPIBase == "" {
-		cfg.DABAPIBase = "https://dabmusic.xyz/api"
+		cfg.DABAPIBase = defaultDABAPIBase
 	}
 	dotEnvBase := normalizeDABAPIBase(readDotEnvValue("BASE"))
 	if dotEnvBase == "" {
 		dotEnvBase = normalizeDABAPIBase(readDotEnvValue("DAB_API_BASE"))
 	}
 	if dotEnvBase != "" {
-		if cfg.DABAPIBase == "" || cfg.DABAPIBase == "https://dabmusic.xyz/api" || cfg.DABAPIBase == "https://dab.yeet.su/api" {
+		if cfg.DABAPIBase == "" || isKnownDABMirror(cfg.DABAPIBase) {
 			cfg.DABAPIBase = dotEnvBase
 		}
 	}
@@ -0,0 +1,326 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+const (
+	MatchStatusMatched   = "matched"
+	MatchStatusReview    = "review"
+	MatchStatusNotFound  = "not-found"
+	MatchStatusCancelled = "cancelled"
+	MatchStatusError     = "error"
+)
+
+type TrackMatch struct {
+	Index      int               `json:"index"`
+	Source     TrackInfo         `json:"source"`
+	Status     string            `json:"status"`
+	Track      *DABTrack         `json:"track,omitempty"`
+	Score      int               `json:"score"`
+	Via        string            `json:"via,omitempty"`
+	Query      string            `json:"query,omitempty"`
+	Breakdown  string            `json:"breakdown,omitempty"`
+	Candidates []ScoredCandidate `json:"candidates"`
+	Error      string            `json:"error,omitempty"`
+}
+
+type AddResult struct {
+	Added     int `json:"added"`
+	Failed    int `json:"failed"`
+	Cancelled int `json:"cancelled"`
+}
+
+func (s *DABService) CreateLibrary(name, description string, tracks []TrackInfo, origin TransferOrigin, onProgress func(string), onTrackStatus func(int, string, string)) (*TransferStats, error) {
+	return s.CreateLibraryContext(context.Background(), name, description, tracks, origin, onProgress, onTrackStatus)
+}
+
+func (s *DABService) CreateLibraryContext(ctx context.Context, name, description string, tracks []TrackInfo, origin TransferOrigin, onProgress func(string), onTrackStatus func(int, string, string)) (*TransferStats, error) {
+	return s.runCheckpointContext(ctx, NewConversionCheckpoint(name, description, tracks, origin), onProgress, onTrackStatus)
+}
+
+func (s *DABService) CommitMatchesContext(ctx context.Context, name, description string, matches []TrackMatch, origin TransferOrigin, onProgress func(string), onTrackStatus func(int, string, string)) (*TransferStats, error) {
+	return s.runCheckpointContext(ctx, checkpointFromMatches(name, description, matches, origin), onProgress, onTrackStatus)
+}
+
+func (s *DABService) MatchTracksContext(ctx context.Context, tracks []TrackInfo, onProgress func(string), onTrackStatus func(int, string, string)) []TrackMatch {
+	return s.matchTracks(ctx, s.matchStore, tracks, onProgress, onTrackStatus)
+}
+
+func (s *DABService) matchTracks(ctx context.Context, store *MatchStore, tracks []TrackInfo, onProgress func(string), onTrackStatus func(int, string, string)) []TrackMatch {
+	onProgress("Searching and matching tracks...")
+
+	matches := make([]TrackMatch, len(tracks))
+	var pending []int
+	if ref, ok := albumContext(tracks); ok {
+		pending = s.matchAlbumTracks(ctx, store, ref, tracks, matches, onProgress, onTrackStatus)
+	} else {
+		pending = make([]int, len(tracks))
+		for i := range tracks {
+			pending[i] = i
+		}
+	}
+	s.matchPending(ctx, store, tracks, pending, matches, onProgress, onTrackStatus)
+
+	if err := store.Flush(); err != nil {
+		onProgress(fmt.Sprintf("⚠ Failed to save match cache: %v", err))
+	}
+	return matches
+}
+
+func (s *DABService) matchPending(ctx context.Context, store *MatchStore, tracks []TrackInfo, pending []int, matches []TrackMatch, onProgress func(string), onTrackStatus func(int, string, string)) {
+	sem := make(chan struct{}, s.concurrency())
+	var wg sync.WaitGroup
+
+	for _, i := range pending {
+		wg.Add(1)
+		go func(i int, t TrackInfo) {
+			defer wg.Done()
+			cancelled := TrackMatch{Index: i, Source: t, Status: MatchStatusCancelled, Candidates: []ScoredCandidate{}}
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				matches[i] = cancelled
+				onTrackStatus(i, "cancelled", "")
+				return
+			}
+			defer func() { <-sem }()
+
+			if ctx.Err() != nil {
+				matches[i] = cancelled
+				onTrackStatus(i, "cancelled", "")
+				return
+			}
+
+			prefix := fmt.Sprintf("[%d/%d]", i+1, len(tracks))
+			onTrackStatus(i, "searching", "")
+
+			m := s.matchTrack(ctx, store, t, prefix, onProgress)
+			m.Index = i
+			matches[i] = m
+
+			switch m.Status {
+			case MatchStatusMatched:
+				onTrackStatus(i, "found", "")
+			case MatchStatusCancelled:
+				onTrackStatus(i, "cancelled", "")
+			default:
+				onTrackStatus(i, "not-found", m.Error)
+			}
+		}(i, tracks[i])
+	}
+	wg.Wait()
+}
+
+func (s *DABService) matchTrack(ctx context.Context, store *MatchStore, t TrackInfo, prefix string, onProgress func(string)) TrackMatch {
+	m := TrackMatch{Source: t, Candidates: []ScoredCandidate{}}
+
+	if cached, ok := store.Lookup(t); ok {
+		track := cached.Track
+		m.Status = MatchStatusMatched
+		m.Track = &track
+		m.Score = cached.Score
+		m.Via = "cache"
+		m.Candidates = []ScoredCandidate{{Track: track, Score: cached.Score}}
+		onProgress(fmt.Sprintf("%s ✓ Matched from cache: %s - %s (Score: %d%%)", prefix, track.Artist, track.Title, cached.Score))
+		return m
+	}
+
+	if t.ISRC != "" {
+		isrcResults, isrcErr := s.SearchByISRCContext(ctx, t.ISRC)
+		if isrcErr == nil && len(isrcResults) > 0 {
+			if res := s.matcher.Match(t, isrcResults); res.Matched {
+				store.Put(t, *res.Track, res.Score)
+				m.apply(res, "isrc")
+				m.Query = t.ISRC
+				onProgress(fmt.Sprintf("%s ✓ Matched by ISRC %s: %s - %s (Score: %d%%) [%s]", prefix, t.ISRC, res.Track.Artist, res.Track.Title, res.Score, res.Breakdown()))
+				return m
+			}
+		}
+		if ctx.Err() != nil {
+			m.Status = MatchStatusCancelled
+			return m
+		}
+	}
+
+	var results []DABTrack
+	var err error
+	var searchSource TrackInfo = t
+	search := func(query string) ([]DABTrack, error) {
+		m.Query = query
+		return s.SearchContext(ctx, query)
+	}
+
+	query1 := fmt.Sprintf("%s %s", t.Artist, t.Title)
+	results, err = search(query1)
+
+	cleanArtist := cleanMetadata(t.Artist)
+	cleanTitle := cleanMetadata(t.Title)
+
+	if err == nil && len(results) == 0 {
+
+		if cleanArtist != t.Artist || cleanTitle != t.Title {
+			query2 := fmt.Sprintf("%s %s", cleanArtist, cleanTitle)
+			results, err = search(query2)
+		}
+
+		if err == nil && len(results) == 0 && cleanTitle != "" {
+			results, err = search(cleanTitle)
+		}
+	}
+
+	if err == nil && len(results) == 0 {
+		onProgress(fmt.Sprintf("%s ℹ Resolving via MusicBrainz...", prefix))
+		mbTrack, mbErr := s.mbService.ResolveTrackMetadataContext(ctx, t.Title, t.Artist)
+		if mbErr != nil {
+			onProgress(fmt.Sprintf("%s ⚠ MusicBrainz error: %v", prefix, mbErr))
+		} else if mbTrack != nil {
+			onProgress(fmt.Sprintf("%s ℹ MusicBrainz found: %s - %s", prefix, mbTrack.Artist, mbTrack.Title))
+
+			searchSource = *mbTrack
+			if searchSource.ISRC == "" {
+				searchSource.ISRC = t.ISRC
+			}
+			if searchSource.Duration == 0 {
+				searchSource.Duration = t.Duration
+			}
+			if searchSource.AlbumTitle == "" {
+				searchSource.AlbumTitle = t.AlbumTitle
+			}
+
+			query4 := fmt.Sprintf("%s %s", mbTrack.Artist, mbTrack.Title)
+
+			results, err = search(query4)
+
+			if err == nil && len(results) == 0 {
+
+				results, err = search(mbTrack.Title)
+			}
+		} else {
+			onProgress(fmt.Sprintf("%s ℹ MusicBrainz found no match", prefix))
+		}
+	}
+
+	if err == nil && len(results) == 0 {
+		words := strings.Fields(cleanTitle)
+		if len(words) > 3 {
+			shortTitle := strings.Join(words[:3], " ")
+
+			results, err = search(shortTitle)
+		}
+	}
+
+	if ctx.Err() != nil {
+		m.Status = MatchStatusCancelled
+		return m
+	}
+
+	if err != nil {
+		onProgress(fmt.Sprintf("%s ✗ Search failed for '%s': %v", prefix, t.Title, err))
+		m.Status = MatchStatusError
+		m.Error = err.Error()
+		return m
+	}
+
+	res := s.matcher.Match(searchSource, results)
+	m.apply(res, "search")
+	if res.Matched {
+		store.Put(t, *res.Track, res.Score)
+		onProgress(fmt.Sprintf("%s ✓ Matched: %s - %s (Score: %d%%) [%s]", prefix, res.Track.Artist, res.Track.Title, res.Score, res.Breakdown()))
+	} else {
+		onProgress(fmt.Sprintf("%s ⚠ No match found for '%s - %s' (Best Score: %d%%, Threshold: %d%%, Candidates: %d) [%s]", prefix, t.Artist, t.Title, res.Score, res.Threshold, res.Candidates, res.Breakdown()))
+	}
+	return m
+}
+
+func (m *TrackMatch) apply(res MatchResult, via string) {
+	m.Score = res.Score
+	m.Via = via
+	m.Breakdown = res.Breakdown()
+	m.Candidates = res.Alternatives
+	switch {
+	case res.Matched:
+		track := *res.Track
+		m.Track = &track
+		m.Status = MatchStatusMatched
+	case len(res.Alternatives) > 0:
+		m.Status = MatchStatusReview
+	default:
+		m.Status = MatchStatusNotFound
+	}
+}
+
+func (s *DABService) AddMatchesContext(ctx context.Context, libraryID string, members *LibraryMembership, matches []TrackMatch, onProgress func(string), onTrackStatus func(int, string, string)) AddResult {
+	var result AddResult
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, s.concurrency())
+
+	markCancelled := func(index int) {
+		onTrackStatus(index, "cancelled", "")
+		mu.Lock()
+		result.Cancelled++
+		mu.Unlock()
+	}
+
+	for i, m := range matches {
+		if m.Track == nil {
+			continue
+		}
+		wg.Add(1)
+		go func(i int, m TrackMatch) {
+			defer wg.Done()
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				markCancelled(m.Index)
+				return
+			}
+			defer func() { <-sem }()
+
+			if ctx.Err() != nil {
+				markCancelled(m.Index)
+				return
+			}
+
+			prefix := fmt.Sprintf("[%d/%d]", i+1, len(matches))
+
+			onTrackStatus(m.Index, "adding", "")
+
+			err := s.addTrackToLibrary(ctx, libraryID, *m.Track, members)
+			if err != nil && ctx.Err() != nil {
+				markCancelled(m.Index)
+				return
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			if errors.Is(err, ErrDABTrackExists) {
+				onProgress(fmt.Sprintf("%s ✓ '%s' already in library", prefix, m.Track.Title))
+				onTrackStatus(m.Index, "added", "")
+				result.Added++
+			} else if err != nil {
+				onProgress(fmt.Sprintf("%s ✗ Failed to add '%s': %v", prefix, m.Track.Title, err))
+				onTrackStatus(m.Index, "error", err.Error())
+				result.Failed++
+			} else {
+				onProgress(fmt.Sprintf("%s ✓ Added '%s'", prefix, m.Track.Title))
+				onTrackStatus(m.Index, "added", "")
+				result.Added++
+			}
+		}(i, m)
+	}
+	wg.Wait()
+	return result
+}
+
+func (s *DABService) concurrency() int {
+	if s.config.MaxConcurrency <= 0 {
+		return 1
+	}
+	return s.config.MaxConcurrency
+}
@@ -1,33 +1,145 @@
 package services
 
 import (
-	"bytes"
-	"crypto/tls"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
-	"io"
+	"log"
 	"net/http"
 	"net/url"
 	"strconv"
 	"strings"
-	"time"
+	"sync"
 )
 
 type DABService struct {
-	config    *Config
-	client    *http.Client
-	mbService *MusicBrainzService
+	config      *Config
+	client      *http.Client
+	mbService   *MusicBrainzService
+	limiter     *RateLimiter
+	mirrors     *mirrorPool
+	lyrics      *lyricsCache
+	matcher     Matcher
+	matchStore  *MatchStore
+	checkpoints *CheckpointStore
+	authMu      sync.Mutex
+	clientMu    sync.RWMutex
+	tokenMu     sync.RWMutex
 }
 
 func NewDABService(cfg *Config) *DABService {
-	tr := &http.Transport{
-		TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
-	}
-	return &DABService{
+	s := &DABService{
 		config:    cfg,
-		client:    &http.Client{Transport: tr},
 		mbService: NewMusicBrainzService(),
+		limiter:   NewRateLimiter(cfg.DABRequestsPerSecond),
+		mirrors:   newMirrorPool(resolveDABAPIBases(cfg)),
+		lyrics:    newLyricsCache(),
+		matcher:   NewWeightedMatcher(cfg),
+	}
+	if cfg.DABAuthToken != "" && cfg.DABAuthBase == "" {
+		cfg.DABAuthBase = s.mirrors.current()
+	}
+	client, err := newHTTPClient(cfg, s.tlsHost())
+	if err != nil {
+		log.Printf("invalid DAB TLS settings, using system defaults: %v", err)
+		client, _ = newHTTPClient(nil, "")
+	}
+	s.client = client
+	return s
+}
+
+func (s *DABService) HTTPClient() *http.Client {
+	s.clientMu.RLock()
+	defer s.clientMu.RUnlock()
+	return s.client
+}
+
+func (s *DABService) ReloadTLS() error {
+	client, err := newHTTPClient(s.config, s.tlsHost())
+	if err != nil {
+		return err
+	}
+	s.clientMu.Lock()
+	s.client = client
+	s.clientMu.Unlock()
+	return nil
+}
+
+func (s *DABService) tlsHost() string {
+	u, err := url.Parse(s.mirrors.primary())
+	if err != nil {
+		return ""
+	}
+	return u.Hostname()
+}
+
+func (s *DABService) AuthToken() string {
+	s.tokenMu.RLock()
+	defer s.tokenMu.RUnlock()
+	return s.config.DABAuthToken
+}
+
+// TokenFor returns the session token only for URLs on the mirror that
+// issued it, so a failover never replays it to another operator.
+func (s *DABService) TokenFor(rawURL string) string {
+	s.tokenMu.RLock()
+	defer s.tokenMu.RUnlock()
+	base := s.config.DABAuthBase
+	if s.config.DABAuthToken == "" || base == "" || !strings.HasPrefix(rawURL, base) {
+		return ""
+	}
+	return s.config.DABAuthToken
+}
+
+func (s *DABService) authBase() string {
+	s.tokenMu.RLock()
+	base := s.config.DABAuthBase
+	s.tokenMu.RUnlock()
+	if base == "" {
+		return s.BaseURL()
 	}
+	return base
+}
+
+func (s *DABService) setAuthSession(base, token string) {
+	s.tokenMu.Lock()
+	s.config.DABAuthBase = base
+	s.config.DABAuthToken = token
+	s.tokenMu.Unlock()
+}
+
+func (s *DABService) ClearAuthSession() {
+	s.setAuthSession("", "")
+}
+
+func (s *DABService) saveConfig() error {
+	s.tokenMu.RLock()
+	defer s.tokenMu.RUnlock()
+	return SaveConfig(s.config)
+}
+
+func (s *DABService) Limiter() *RateLimiter {
+	return s.limiter
+}
+
+func (s *DABService) Matcher() Matcher {
+	return s.matcher
+}
+
+func (s *DABService) SetMatchStore(ms *MatchStore) {
+	s.matchStore = ms
+}
+
+func (s *DABService) MatchStore() *MatchStore {
+	return s.matchStore
+}
+
+func (s *DABService) SetMatcher(m Matcher) {
+	if m == nil {
+		m = NewWeightedMatcher(s.config)
+	}
+	s.matcher = m
 }
 
 func (s *DABService) logRequest(req *http.Request) {
@@ -37,7 +149,20 @@ func (s *DABService) logResponse(resp *http.Response, err error) {
 }
 
 func (s *DABService) Login(email, password string) (string, error) {
-	base := resolveDABAPIBase(s.config)
+	return s.LoginContext(context.Background(), email, password)
+}
+
+func (s *DABService) LoginContext(ctx context.Context, email, password string) (string, error) {
+	base := s.BaseURL()
+	token, err := s.loginAt(ctx, base, email, password)
+	if err != nil {
+		return "", err
+	}
+	s.setAuthSession(base, token)
+	return token, nil
+}
+
+func (s *DABService) loginAt(ctx context.Context, base, email, password string) (string, error) {
 	endpoint := fmt.Sprintf("%s/auth/login", base)
 	payload := map[string]string{
 		"email":    email,
@@ -45,45 +170,18 @@ func (s *DABService) Login(email, password string) (string, error) {
 	}
 	data, _ := json.Marshal(payload)
 
-	req, err := http.NewRequest("POST", endpoint, bytes.NewBuffer(data))
-	if err != nil {
-		return "", err
-	}
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Accept", "application/json")
-	req.Header.Set("User-Agent", "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/1337.0.0.0 Safari/537.36")
-	origin := originFromBase(base)
-	if origin != "" {
-		req.Header.Set("Origin", origin)
-		req.Header.Set("Referer", origin+"/")
-	} else {
-		req.Header.Del("Origin")
-		req.Header.Del("Referer")
-	}
-
-	s.logRequest(req)
-	resp, err := s.client.Do(req)
-	s.logResponse(resp, err)
+	resp, err := s.executeWithToken(ctx, "POST", endpoint, data, "")
 	if err != nil {
 		return "", err
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != 200 {
-		b, _ := io.ReadAll(io.LimitReader(resp.Body, 2048))
-		msg := strings.TrimSpace(string(b))
-		ct := strings.ToLower(resp.Header.Get("Content-Type"))
-		lower := strings.ToLower(msg)
-		if resp.StatusCode == 403 && (strings.Contains(lower, "just a moment") || strings.Contains(lower, "cf-") || strings.Contains(lower, "cloudflare")) {
-			return "", fmt.Errorf("login blocked by Cloudflare at %s. Set BASE to a DAB API endpoint that doesn't require a browser challenge", base)
-		}
-		if strings.Contains(ct, "text/html") || strings.HasPrefix(lower, "<!doctype") || strings.HasPrefix(lower, "<html") {
-			return "", fmt.Errorf("login failed with status: %d", resp.StatusCode)
-		}
-		if msg != "" {
-			return "", fmt.Errorf("login failed with status: %d: %s", resp.StatusCode, msg)
+		err := statusError(resp)
+		if errors.Is(err, ErrDABCloudflare) {
+			return "", fmt.Errorf("login blocked by Cloudflare at %s. Set BASE to a DAB API endpoint that doesn't require a browser challenge: %w", base, err)
 		}
-		return "", fmt.Errorf("login failed with status: %d", resp.StatusCode)
+		return "", fmt.Errorf("login failed: %w", err)
 	}
 
 	var token string
@@ -95,7 +193,7 @@ func (s *DABService) Login(email, password string) (string, error) {
 	}
 
 	if token == "" {
-		return "", fmt.Errorf("session cookie not found")
+		return "", fmt.Errorf("session cookie not found: %w", ErrDABUnauthorized)
 	}
 
 	return token, nil
@@ -108,83 +206,28 @@ type AudioQuality struct {
 }
 
 type DABTrack struct {
-	ID           interface{}  `json:"id"`
-	Title        string       `json:"title"`
-	Artist       string       `json:"artist"`
-	ArtistID     interface{}  `json:"artistId"`
-	AlbumTitle   string       `json:"albumTitle"`
-	AlbumCover   string       `json:"albumCover"`
-	AlbumID      interface{}  `json:"albumId"`
-	ReleaseDate  string       `json:"releaseDate"`
-	Genre        string       `json:"genre"`
-	Duration     interface{}  `json:"duration"`
-	AudioQuality AudioQuality `json:"audioQuality"`
+	ID              interface{}  `json:"id"`
+	Title           string       `json:"title"`
+	Artist          string       `json:"artist"`
+	ArtistID        interface{}  `json:"artistId"`
+	AlbumTitle      string       `json:"albumTitle"`
+	AlbumCover      string       `json:"albumCover"`
+	AlbumID         interface{}  `json:"albumId"`
+	ReleaseDate     string       `json:"releaseDate"`
+	Genre           string       `json:"genre"`
+	Duration        interface{}  `json:"duration"`
+	AudioQuality    AudioQuality `json:"audioQuality"`
+	TrackNumber     int          `json:"trackNumber,omitempty"`
+	DiscNumber      int          `json:"discNumber,omitempty"`
+	ISRC            string       `json:"isrc,omitempty"`
+	ParentalWarning bool         `json:"parentalWarning,omitempty"`
 }
 
-type SearchResponse struct {
-	Tracks []DABTrack `json:"tracks"`
-}
-
-func (s *DABService) Search(query string) ([]DABTrack, error) {
-
-	time.Sleep(600 * time.Millisecond)
-
-	base := resolveDABAPIBase(s.config)
-	u, err := url.Parse(base + "/search")
-	if err != nil {
-		return nil, err
-	}
-
-	q := u.Query()
-	q.Set("q", query)
-	q.Set("type", "track")
-	u.RawQuery = q.Encode()
-
-	req, err := http.NewRequest("GET", u.String(), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	req.Header.Set("User-Agent", "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/1337.0.0.0 Safari/537.36")
-	req.Header.Set("Accept", "application/json")
-
-	if s.config.DABAuthToken != "" {
-		req.AddCookie(&http.Cookie{Name: "session", Value: s.config.DABAuthToken})
-	}
-
-	s.logRequest(req)
-	resp, err := s.client.Do(req)
-	s.logResponse(resp, err)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != 200 {
-		return nil, fmt.Errorf("search failed: %d", resp.StatusCode)
-	}
-
-	var result SearchResponse
-
-	var body bytes.Buffer
-	_, err = body.ReadFrom(resp.Body)
-	if err != nil {
-		return nil, err
-	}
-
-	if err := json.Unmarshal(body.Bytes(), &result); err == nil && len(result.Tracks) > 0 {
-		return result.Tracks, nil
-	}
-
-	var listResult []DABTrack
-	if err := json.Unmarshal(body.Bytes(), &listResult); err == nil {
-		return listResult, nil
-	}
-
-	return []DABTrack{}, nil
+func (s *DABService) GetStreamURL(trackID interface{}) (string, error) {
+	return s.GetStreamURLContext(context.Background(), trackID)
 }
 
-func (s *DABService) GetStreamURL(trackID interface{}) (string, error) {
+func (s *DABService) GetStreamURLContext(ctx context.Context, trackID interface{}) (string, error) {
 	idStr := fmt.Sprintf("%v", trackID)
 
 	if idFloat, ok := trackID.(float64); ok {
@@ -196,30 +239,17 @@ func (s *DABService) GetStreamURL(trackID interface{}) (string, error) {
 		}
 	}
 
-	base := resolveDABAPIBase(s.config)
+	base := s.BaseURL()
 	url := fmt.Sprintf("%s/stream?trackId=%s", base, idStr)
 
-	req, err := http.NewRequest("GET", url, nil)
-	if err != nil {
-		return "", err
-	}
-
-	req.Header.Set("User-Agent", "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/1337.0.0.0 Safari/537.36")
-
-	if s.config.DABAuthToken != "" {
-		req.AddCookie(&http.Cookie{Name: "session", Value: s.config.DABAuthToken})
-	}
-
-	s.logRequest(req)
-	resp, err := s.client.Do(req)
-	s.logResponse(resp, err)
+	resp, err := s.execute(ctx, "GET", url, nil)
 	if err != nil {
 		return "", err
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != 200 {
-		return "", fmt.Errorf("failed to get stream url: %d", resp.StatusCode)
+		return "", fmt.Errorf("failed to get stream url: %w", statusError(resp))
 	}
 
 	var result struct {
@@ -237,34 +267,6 @@ func (s *DABService) GetStreamURL(trackID interface{}) (string, error) {
 	return result.AltURL, nil
 }
 
-func (s *DABService) GetAlbumInfo(albumID string) (interface{}, error) {
-	url := fmt.Sprintf("%s/api/album?albumId=%s", resolveDABAPIBase(s.config), albumID)
-	return s.fetchJSON(url)
-}
-
-func (s *DABService) fetchJSON(url string) (interface{}, error) {
-	req, err := http.NewRequest("GET", url, nil)
-	if err != nil {
-		return nil, err
-	}
-
-	req.Header.Set("User-Agent", "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/1337.0.0.0 Safari/537.36")
-
-	s.logRequest(req)
-	resp, err := s.client.Do(req)
-	s.logResponse(resp, err)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	var result interface{}
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		return nil, err
-	}
-	return result, nil
-}
-
 type Library struct {
 	ID         string `json:"id"`
 	Name       string `json:"name"`
@@ -292,68 +294,57 @@ type FavoritesResponse struct {
 }
 
 func (s *DABService) GetFavorites() ([]DABTrack, error) {
-	url := fmt.Sprintf("%s/favorites", resolveDABAPIBase(s.config))
-	var result FavoritesResponse
-	err := s.fetchJSONInto(url, &result)
-	return result.Favorites, err
+	return s.GetFavoritesContext(context.Background())
+}
+
+func (s *DABService) GetFavoritesContext(ctx context.Context) ([]DABTrack, error) {
+	favorites := []DABTrack{}
+	err := s.IterateFavorites(ctx, defaultTrackPageSize, func(page TrackPage) error {
+		favorites = append(favorites, page.Tracks...)
+		return nil
+	})
+	return favorites, err
 }
 
 func (s *DABService) AddToFavorites(track DABTrack) error {
-	url := fmt.Sprintf("%s/favorites", resolveDABAPIBase(s.config))
+	return s.AddToFavoritesContext(context.Background(), track)
+}
+
+func (s *DABService) AddToFavoritesContext(ctx context.Context, track DABTrack) error {
+	url := fmt.Sprintf("%s/favorites", s.BaseURL())
 	payload := map[string]interface{}{
 		"track": track,
 	}
 	data, _ := json.Marshal(payload)
-	return s.postJSON(url, data)
+	return s.postJSON(ctx, url, data)
 }
 
 func (s *DABService) RemoveFromFavorites(trackID string) error {
-	url := fmt.Sprintf("%s/favorites?trackId=%s", resolveDABAPIBase(s.config), trackID)
-	req, err := http.NewRequest("DELETE", url, nil)
-	if err != nil {
-		return err
-	}
-	req.Header.Set("User-Agent", "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/1337.0.0.0 Safari/537.36")
-	if s.config.DABAuthToken != "" {
-		req.AddCookie(&http.Cookie{Name: "session", Value: s.config.DABAuthToken})
-	}
+	return s.RemoveFromFavoritesContext(context.Background(), trackID)
+}
 
-	s.logRequest(req)
-	resp, err := s.client.Do(req)
-	s.logResponse(resp, err)
+func (s *DABService) RemoveFromFavoritesContext(ctx context.Context, trackID string) error {
+	url := fmt.Sprintf("%s/favorites?trackId=%s", s.BaseURL(), trackID)
+	resp, err := s.execute(ctx, "DELETE", url, nil)
 	if err != nil {
 		return err
 	}
 	defer resp.Body.Close()
 	if resp.StatusCode != 200 {
-		return fmt.Errorf("failed to remove from favorites: %d", resp.StatusCode)
+		return fmt.Errorf("failed to remove from favorites: %w", statusError(resp))
 	}
 	return nil
 }
 
 func (s *DABService) GetLibraries() ([]Library, error) {
-	url := fmt.Sprintf("%s/libraries", resolveDABAPIBase(s.config))
-	var result LibrariesResponse
-	err := s.fetchJSONInto(url, &result)
-	return result.Libraries, err
+	return s.GetLibrariesContext(context.Background())
 }
 
-func (s *DABService) GetLyrics(artist, title string) (map[string]interface{}, error) {
-	url := fmt.Sprintf("%s/lyrics?artist=%s&title=%s",
-		resolveDABAPIBase(s.config),
-		urlQueryEscape(artist),
-		urlQueryEscape(title))
-
-	var result map[string]interface{}
-	err := s.fetchJSONInto(url, &result)
-	return result, err
-}
-
-func (s *DABService) GetAlbumByID(albumID string) (map[string]interface{}, error) {
-	url := fmt.Sprintf("%s/album?albumId=%s", resolveDABAPIBase(s.config), albumID)
-	var result map[string]interface{}
-	err := s.fetchJSONInto(url, &result)
-	return result, err
+func (s *DABService) GetLibrariesContext(ctx context.Context) ([]Library, error) {
+	url := fmt.Sprintf("%s/libraries", s.BaseURL())
+	var result LibrariesResponse
+	err := s.fetchJSONInto(ctx, url, &result)
+	return result.Libraries, err
 }
 
 type QueueResponse struct {
@@ -361,45 +352,52 @@ type QueueResponse struct {
 }
 
 func (s *DABService) GetQueue() ([]DABTrack, error) {
-	url := fmt.Sprintf("%s/queue", resolveDABAPIBase(s.config))
+	return s.GetQueueContext(context.Background())
+}
+
+func (s *DABService) GetQueueContext(ctx context.Context) ([]DABTrack, error) {
+	url := fmt.Sprintf("%s/queue", s.BaseURL())
 	var result QueueResponse
-	err := s.fetchJSONInto(url, &result)
+	err := s.fetchJSONInto(ctx, url, &result)
 	return result.Queue, err
 }
 
 func (s *DABService) SaveQueue(queue []DABTrack) error {
-	url := fmt.Sprintf("%s/queue", resolveDABAPIBase(s.config))
+	return s.SaveQueueContext(context.Background(), queue)
+}
+
+func (s *DABService) SaveQueueContext(ctx context.Context, queue []DABTrack) error {
+	url := fmt.Sprintf("%s/queue", s.BaseURL())
 	payload := map[string]interface{}{
 		"queue": queue,
 	}
 	data, _ := json.Marshal(payload)
-	return s.postJSON(url, data)
+	return s.postJSON(ctx, url, data)
 }
 
 func (s *DABService) ClearQueue() error {
-	url := fmt.Sprintf("%s/queue", resolveDABAPIBase(s.config))
-	req, err := http.NewRequest("DELETE", url, nil)
-	if err != nil {
-		return err
-	}
-	req.Header.Set("User-Agent", "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36")
-	if s.config.DABAuthToken != "" {
-		req.AddCookie(&http.Cookie{Name: "session", Value: s.config.DABAuthToken})
-	}
+	return s.ClearQueueContext(context.Background())
+}
 
-	resp, err := s.client.Do(req)
+func (s *DABService) ClearQueueContext(ctx context.Context) error {
+	url := fmt.Sprintf("%s/queue", s.BaseURL())
+	resp, err := s.execute(ctx, "DELETE", url, nil)
 	if err != nil {
 		return err
 	}
 	defer resp.Body.Close()
 	if resp.StatusCode != 200 {
-		return fmt.Errorf("failed to clear queue: %d", resp.StatusCode)
+		return fmt.Errorf("failed to clear queue: %w", statusError(resp))
 	}
 	return nil
 }
 
 func (s *DABService) UpdateLibrary(libraryID, name, description string, isPublic bool) error {
-	url := fmt.Sprintf("%s/libraries/%s", resolveDABAPIBase(s.config), libraryID)
+	return s.UpdateLibraryContext(context.Background(), libraryID, name, description, isPublic)
+}
+
+func (s *DABService) UpdateLibraryContext(ctx context.Context, libraryID, name, description string, isPublic bool) error {
+	url := fmt.Sprintf("%s/libraries/%s", s.BaseURL(), libraryID)
 	payload := map[string]interface{}{
 		"name":        name,
 		"description": description,
@@ -407,53 +405,42 @@ func (s *DABService) UpdateLibrary(libraryID, name, description string, isPublic
 	}
 	data, _ := json.Marshal(payload)
 
-	req, err := http.NewRequest("PATCH", url, bytes.NewBuffer(data))
-	if err != nil {
-		return err
-	}
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("User-Agent", "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36")
-	if s.config.DABAuthToken != "" {
-		req.AddCookie(&http.Cookie{Name: "session", Value: s.config.DABAuthToken})
-	}
-
-	resp, err := s.client.Do(req)
+	resp, err := s.execute(ctx, "PATCH", url, data)
 	if err != nil {
 		return err
 	}
 	defer resp.Body.Close()
 	if resp.StatusCode != 200 {
-		return fmt.Errorf("failed to update library: %d", resp.StatusCode)
+		return fmt.Errorf("failed to update library: %w", statusError(resp))
 	}
 	return nil
 }
 
 func (s *DABService) DeleteLibrary(libraryID string) error {
-	url := fmt.Sprintf("%s/libraries/%s", resolveDABAPIBase(s.config), libraryID)
-	req, err := http.NewRequest("DELETE", url, nil)
-	if err != nil {
-		return err
-	}
-	req.Header.Set("User-Agent", "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36")
-	if s.config.DABAuthToken != "" {
-		req.AddCookie(&http.Cookie{Name: "session", Value: s.config.DABAuthToken})
-	}
+	return s.DeleteLibraryContext(context.Background(), libraryID)
+}
 
-	resp, err := s.client.Do(req)
+func (s *DABService) DeleteLibraryContext(ctx context.Context, libraryID string) error {
+	url := fmt.Sprintf("%s/libraries/%s", s.BaseURL(), libraryID)
+	resp, err := s.execute(ctx, "DELETE", url, nil)
 	if err != nil {
 		return err
 	}
 	defer resp.Body.Close()
 	if resp.StatusCode != 200 && resp.StatusCode != 204 {
-		return fmt.Errorf("failed to delete library: %d", resp.StatusCode)
+		return fmt.Errorf("failed to delete library: %w", statusError(resp))
 	}
 	return nil
 }
 
 func (s *DABService) GetCurrentUser() (map[string]interface{}, error) {
-	url := fmt.Sprintf("%s/auth/me", resolveDABAPIBase(s.config))
+	return s.GetCurrentUserContext(context.Background())
+}
+
+func (s *DABService) GetCurrentUserContext(ctx context.Context) (map[string]interface{}, error) {
+	url := fmt.Sprintf("%s/auth/me", s.BaseURL())
 	var result map[string]interface{}
-	err := s.fetchJSONInto(url, &result)
+	err := s.fetchJSONInto(ctx, url, &result)
 	return result, err
 }
 
@@ -462,85 +449,29 @@ func urlQueryEscape(s string) string {
 }
 
 func (s *DABService) GetLibraryDetails(libraryID string) (*LibraryDetailsResponse, error) {
-
-	base := resolveDABAPIBase(s.config)
-	url := fmt.Sprintf("%s/libraries/%s?limit=1000", base, libraryID)
-	var result LibraryDetailsWrapper
-	err := s.fetchJSONInto(url, &result)
-	if err == nil {
-		return &result.Library, nil
-	}
-
-	sharedUrl := fmt.Sprintf("%s/shared/library/%s?limit=1000", base, libraryID)
-	errShared := s.fetchJSONInto(sharedUrl, &result)
-	if errShared == nil {
-		return &result.Library, nil
-	}
-
-	return nil, fmt.Errorf("failed to fetch library details: %v (private), %v (shared)", err, errShared)
+	return s.GetLibraryDetailsContext(context.Background(), libraryID)
 }
 
-func (s *DABService) fetchJSONInto(url string, target interface{}) error {
-	req, err := http.NewRequest("GET", url, nil)
+func (s *DABService) GetLibraryDetailsContext(ctx context.Context, libraryID string) (*LibraryDetailsResponse, error) {
+	var tracks []DABTrack
+	details, err := s.IterateLibraryTracks(ctx, libraryID, defaultTrackPageSize, func(page TrackPage) error {
+		tracks = append(tracks, page.Tracks...)
+		return nil
+	})
 	if err != nil {
-		return err
-	}
-	req.Header.Set("User-Agent", "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/1337.0.0.0 Safari/537.36")
-	if origin := originFromBase(resolveDABAPIBase(s.config)); origin != "" {
-		req.Header.Set("Origin", origin)
-		req.Header.Set("Referer", origin+"/")
-	} else {
-		req.Header.Del("Origin")
-		req.Header.Del("Referer")
-	}
-
-	if s.config.DABAuthToken != "" {
-		req.AddCookie(&http.Cookie{Name: "session", Value: s.config.DABAuthToken})
-	}
-
-	s.logRequest(req)
-	resp, err := s.client.Do(req)
-	s.logResponse(resp, err)
-	if err != nil {
-		return err
+		return nil, err
 	}
-	defer resp.Body.Close()
-	if resp.StatusCode != 200 {
-		b, _ := io.ReadAll(io.LimitReader(resp.Body, 2048))
-		msg := strings.TrimSpace(string(b))
-		ct := strings.ToLower(resp.Header.Get("Content-Type"))
-		lower := strings.ToLower(msg)
-		if strings.Contains(ct, "text/html") || strings.HasPrefix(lower, "<!doctype") || strings.HasPrefix(lower, "<html") {
-			return fmt.Errorf("request failed: %d", resp.StatusCode)
-		}
-		if msg != "" {
-			return fmt.Errorf("request failed: %d: %s", resp.StatusCode, msg)
-		}
-		return fmt.Errorf("request failed: %d", resp.StatusCode)
+	if tracks == nil {
+		tracks = []DABTrack{}
 	}
-	return json.NewDecoder(resp.Body).Decode(target)
+	details.Tracks = tracks
+	return details, nil
 }
 
-func (s *DABService) postJSON(url string, data []byte) error {
-	req, err := http.NewRequest("POST", url, bytes.NewBuffer(data))
-	if err != nil {
-		return err
-	}
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("User-Agent", "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/1337.0.0.0 Safari/537.36")
-	if s.config.DABAuthToken != "" {
-		req.AddCookie(&http.Cookie{Name: "session", Value: s.config.DABAuthToken})
-	}
+func (s *DABService) fetchJSONInto(ctx context.Context, url string, target interface{}) error {
+	return s.doJSON(ctx, "GET", url, nil, target)
+}
 
-	s.logRequest(req)
-	resp, err := s.client.Do(req)
-	s.logResponse(resp, err)
-	if err != nil {
-		return err
-	}
-	defer resp.Body.Close()
-	if resp.StatusCode != 200 && resp.StatusCode != 201 {
-		return fmt.Errorf("request failed: %d", resp.StatusCode)
-	}
-	return nil
+func (s *DABService) postJSON(ctx context.Context, url string, data []byte) error {
+	return s.doJSON(ctx, "POST", url, data, nil)
 }
@@ -2,10 +2,12 @@ package services
 
 import (
 	"bufio"
+	"context"
 	"net/url"
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 )
 
 func resolveDABAPIBase(cfg *Config) string {
@@ -27,11 +29,41 @@ func resolveDABAPIBase(cfg *Config) string {
 	}
 	base = normalizeDABAPIBase(base)
 	if base == "" {
-		base = "https://dabmusic.xyz/api"
+		base = defaultDABAPIBase
 	}
 	return base
 }
 
+// resolveDABAPIBases only returns bases the user chose; public mirrors are
+// run by other operators and are never added behind the user's back.
+func resolveDABAPIBases(cfg *Config) []string {
+	bases := make([]string, 0, 1)
+	seen := map[string]struct{}{}
+	add := func(b string) {
+		b = normalizeDABAPIBase(b)
+		if b == "" {
+			return
+		}
+		if _, ok := seen[b]; ok {
+			return
+		}
+		seen[b] = struct{}{}
+		bases = append(bases, b)
+	}
+
+	if cfg != nil && len(cfg.DABAPIBases) > 0 {
+		for _, b := range cfg.DABAPIBases {
+			add(b)
+		}
+	}
+	if len(bases) > 0 {
+		return bases
+	}
+
+	add(resolveDABAPIBase(cfg))
+	return bases
+}
+
 func normalizeDABAPIBase(base string) string {
 	base = strings.TrimSpace(base)
 	base = strings.TrimRight(base, "/")
@@ -123,3 +155,17 @@ func readDotEnvFileValue(path string, key string) (string, bool) {
 	}
 	return "", false
 }
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
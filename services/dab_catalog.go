@@ -0,0 +1,168 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+)
+
+type DABAlbum struct {
+	ID           interface{}  `json:"id"`
+	Title        string       `json:"title"`
+	Artist       string       `json:"artist"`
+	ArtistID     interface{}  `json:"artistId"`
+	Cover        string       `json:"cover"`
+	ReleaseDate  string       `json:"releaseDate"`
+	Genre        string       `json:"genre"`
+	Label        string       `json:"label"`
+	UPC          string       `json:"upc"`
+	TrackCount   int          `json:"trackCount"`
+	DiscCount    int          `json:"discCount"`
+	AudioQuality AudioQuality `json:"audioQuality"`
+	Tracks       []DABTrack   `json:"tracks"`
+}
+
+type DABArtist struct {
+	ID         interface{} `json:"id"`
+	Name       string      `json:"name"`
+	Picture    string      `json:"picture"`
+	Bio        string      `json:"bio,omitempty"`
+	AlbumCount int         `json:"albumCount"`
+	Albums     []DABAlbum  `json:"albums"`
+}
+
+func (s *DABService) GetAlbumByID(albumID string) (*DABAlbum, error) {
+	return s.GetAlbumByIDContext(context.Background(), albumID)
+}
+
+func (s *DABService) GetAlbumByIDContext(ctx context.Context, albumID string) (*DABAlbum, error) {
+	url := fmt.Sprintf("%s/album?albumId=%s", s.BaseURL(), urlQueryEscape(albumID))
+	var raw json.RawMessage
+	if err := s.fetchJSONInto(ctx, url, &raw); err != nil {
+		return nil, err
+	}
+
+	var wrapped struct {
+		Album *DABAlbum `json:"album"`
+	}
+	if err := json.Unmarshal(raw, &wrapped); err != nil {
+		return nil, err
+	}
+	album := wrapped.Album
+	if album == nil {
+		album = &DABAlbum{}
+		if err := json.Unmarshal(raw, album); err != nil {
+			return nil, err
+		}
+	}
+	if album.ID == nil && album.Title == "" {
+		return nil, fmt.Errorf("album %s: %w", albumID, ErrDABNotFound)
+	}
+
+	album.normalize()
+	return album, nil
+}
+
+func (s *DABService) GetArtist(artistID string) (*DABArtist, error) {
+	return s.GetArtistContext(context.Background(), artistID)
+}
+
+func (s *DABService) GetArtistContext(ctx context.Context, artistID string) (*DABArtist, error) {
+	url := fmt.Sprintf("%s/discography?artistId=%s", s.BaseURL(), urlQueryEscape(artistID))
+	var result struct {
+		Artist DABArtist  `json:"artist"`
+		Albums []DABAlbum `json:"albums"`
+	}
+	if err := s.fetchJSONInto(ctx, url, &result); err != nil {
+		return nil, err
+	}
+
+	artist := result.Artist
+	if len(result.Albums) > 0 {
+		artist.Albums = result.Albums
+	}
+	if artist.ID == nil {
+		artist.ID = artistID
+	}
+	if artist.Albums == nil {
+		artist.Albums = []DABAlbum{}
+	}
+	for i := range artist.Albums {
+		artist.Albums[i].normalize()
+	}
+	if artist.AlbumCount == 0 {
+		artist.AlbumCount = len(artist.Albums)
+	}
+	return &artist, nil
+}
+
+func (s *DABService) GetArtistDiscography(artistID string) ([]DABAlbum, error) {
+	return s.GetArtistDiscographyContext(context.Background(), artistID)
+}
+
+func (s *DABService) GetArtistDiscographyContext(ctx context.Context, artistID string) ([]DABAlbum, error) {
+	artist, err := s.GetArtistContext(ctx, artistID)
+	if err != nil {
+		return nil, err
+	}
+	return artist.Albums, nil
+}
+
+func (a *DABAlbum) normalize() {
+	if a.Tracks == nil {
+		a.Tracks = []DABTrack{}
+	}
+	maxDisc := 0
+	for i := range a.Tracks {
+		t := &a.Tracks[i]
+		if t.DiscNumber == 0 {
+			t.DiscNumber = 1
+		}
+		if t.TrackNumber == 0 {
+			t.TrackNumber = i + 1
+		}
+		if t.AlbumTitle == "" {
+			t.AlbumTitle = a.Title
+		}
+		if t.AlbumCover == "" {
+			t.AlbumCover = a.Cover
+		}
+		if t.AlbumID == nil {
+			t.AlbumID = a.ID
+		}
+		if t.Artist == "" {
+			t.Artist = a.Artist
+		}
+		if t.ReleaseDate == "" {
+			t.ReleaseDate = a.ReleaseDate
+		}
+		if t.Genre == "" {
+			t.Genre = a.Genre
+		}
+		if t.DiscNumber > maxDisc {
+			maxDisc = t.DiscNumber
+		}
+	}
+	sort.SliceStable(a.Tracks, func(i, j int) bool {
+		if a.Tracks[i].DiscNumber != a.Tracks[j].DiscNumber {
+			return a.Tracks[i].DiscNumber < a.Tracks[j].DiscNumber
+		}
+		return a.Tracks[i].TrackNumber < a.Tracks[j].TrackNumber
+	})
+	if a.TrackCount == 0 {
+		a.TrackCount = len(a.Tracks)
+	}
+	if a.DiscCount == 0 {
+		a.DiscCount = maxDisc
+	}
+}
+
+func (a *DABAlbum) Track(disc, number int) (*DABTrack, bool) {
+	for i := range a.Tracks {
+		if a.Tracks[i].DiscNumber == disc && a.Tracks[i].TrackNumber == number {
+			return &a.Tracks[i], true
+		}
+	}
+	return nil, false
+}
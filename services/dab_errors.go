@@ -0,0 +1,104 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+var (
+	ErrDABUnauthorized = errors.New("dab session is not authorized")
+	ErrDABRateLimited  = errors.New("dab rate limit exceeded")
+	ErrDABCloudflare   = errors.New("dab request blocked by a Cloudflare challenge")
+	ErrDABNotFound     = errors.New("dab resource not found")
+	ErrDABServer       = errors.New("dab server error")
+	ErrDABTrackExists  = errors.New("track already exists in library")
+)
+
+type DABError struct {
+	StatusCode int
+	Method     string
+	URL        string
+	Message    string
+	Kind       error
+}
+
+func (e *DABError) Error() string {
+	if e.Message != "" {
+		return fmt.Sprintf("request failed: %d: %s", e.StatusCode, e.Message)
+	}
+	return fmt.Sprintf("request failed: %d", e.StatusCode)
+}
+
+func (e *DABError) Unwrap() error {
+	return e.Kind
+}
+
+func statusError(resp *http.Response) error {
+	b, _ := io.ReadAll(io.LimitReader(resp.Body, dabErrorBodyLimit))
+	msg := strings.TrimSpace(string(b))
+	lower := strings.ToLower(msg)
+
+	e := &DABError{StatusCode: resp.StatusCode}
+	if resp.Request != nil {
+		e.Method = resp.Request.Method
+		e.URL = resp.Request.URL.String()
+	}
+
+	isHTML := strings.Contains(strings.ToLower(resp.Header.Get("Content-Type")), "text/html") ||
+		strings.HasPrefix(lower, "<!doctype") || strings.HasPrefix(lower, "<html")
+	if !isHTML {
+		e.Message = msg
+	}
+
+	switch {
+	case isCloudflareChallenge(resp, lower):
+		e.Kind = ErrDABCloudflare
+	case resp.StatusCode == http.StatusUnauthorized:
+		e.Kind = ErrDABUnauthorized
+	case resp.StatusCode == http.StatusNotFound:
+		e.Kind = ErrDABNotFound
+	case resp.StatusCode == http.StatusTooManyRequests:
+		e.Kind = ErrDABRateLimited
+	case resp.StatusCode >= 500:
+		e.Kind = ErrDABServer
+	}
+	return e
+}
+
+func isCloudflareChallenge(resp *http.Response, lowerBody string) bool {
+	if resp.StatusCode != http.StatusForbidden && resp.StatusCode != http.StatusServiceUnavailable {
+		return false
+	}
+	if strings.EqualFold(resp.Header.Get("Cf-Mitigated"), "challenge") {
+		return true
+	}
+	return strings.Contains(lowerBody, "just a moment") || strings.Contains(lowerBody, "cf-") || strings.Contains(lowerBody, "cloudflare")
+}
+
+func (s *DABService) Reauthenticate(ctx context.Context) error {
+	return s.reauthenticate(ctx, s.AuthToken())
+}
+
+func (s *DABService) reauthenticate(ctx context.Context, staleToken string) error {
+	s.authMu.Lock()
+	defer s.authMu.Unlock()
+
+	if current := s.AuthToken(); current != "" && current != staleToken {
+		return nil
+	}
+	if s.config.DABEmail == "" || s.config.DABPassword == "" {
+		return ErrDABUnauthorized
+	}
+
+	base := s.authBase()
+	token, err := s.loginAt(ctx, base, s.config.DABEmail, s.config.DABPassword)
+	if err != nil {
+		return err
+	}
+	s.setAuthSession(base, token)
+	return s.saveConfig()
+}
@@ -0,0 +1,254 @@
+package services
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultDABAPIBase     = "https://dabmusic.xyz/api"
+	dabHealthProbeTimeout = 10 * time.Second
+)
+
+var knownDABMirrors = []string{
+	defaultDABAPIBase,
+	"https://dab.yeet.su/api",
+}
+
+func isKnownDABMirror(base string) bool {
+	for _, m := range knownDABMirrors {
+		if base == m {
+			return true
+		}
+	}
+	return false
+}
+
+type DABMirror struct {
+	Base      string `json:"base"`
+	Healthy   bool   `json:"healthy"`
+	Active    bool   `json:"active"`
+	LatencyMs int64  `json:"latencyMs"`
+	LastError string `json:"lastError,omitempty"`
+	CheckedAt string `json:"checkedAt,omitempty"`
+}
+
+type mirrorPool struct {
+	mu       sync.RWMutex
+	mirrors  []DABMirror
+	active   int
+	onChange func(DABMirror)
+}
+
+func newMirrorPool(bases []string) *mirrorPool {
+	p := &mirrorPool{}
+	p.reset(bases)
+	return p
+}
+
+func (p *mirrorPool) reset(bases []string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.mirrors = make([]DABMirror, 0, len(bases))
+	for _, b := range bases {
+		p.mirrors = append(p.mirrors, DABMirror{Base: b, Healthy: true})
+	}
+	p.active = 0
+}
+
+func (p *mirrorPool) current() string {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	if len(p.mirrors) == 0 {
+		return defaultDABAPIBase
+	}
+	return p.mirrors[p.active].Base
+}
+
+func (p *mirrorPool) primary() string {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	if len(p.mirrors) == 0 {
+		return defaultDABAPIBase
+	}
+	return p.mirrors[0].Base
+}
+
+func (p *mirrorPool) snapshot() []DABMirror {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	out := make([]DABMirror, len(p.mirrors))
+	copy(out, p.mirrors)
+	for i := range out {
+		out[i].Active = i == p.active
+	}
+	return out
+}
+
+func (p *mirrorPool) owns(rawURL string) (string, bool) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	for _, m := range p.mirrors {
+		if strings.HasPrefix(rawURL, m.Base) {
+			return m.Base, true
+		}
+	}
+	return "", false
+}
+
+func (p *mirrorPool) markUnhealthy(base string, reason string) {
+	p.mu.Lock()
+	for i := range p.mirrors {
+		if p.mirrors[i].Base == base {
+			p.mirrors[i].Healthy = false
+			p.mirrors[i].LastError = reason
+			p.mirrors[i].CheckedAt = time.Now().Format(time.RFC3339)
+		}
+	}
+	changed := p.selectActiveLocked()
+	p.mu.Unlock()
+	p.notify(changed)
+}
+
+func (p *mirrorPool) record(base string, healthy bool, latency time.Duration, reason string) {
+	p.mu.Lock()
+	for i := range p.mirrors {
+		if p.mirrors[i].Base == base {
+			p.mirrors[i].Healthy = healthy
+			p.mirrors[i].LatencyMs = latency.Milliseconds()
+			p.mirrors[i].LastError = reason
+			p.mirrors[i].CheckedAt = time.Now().Format(time.RFC3339)
+		}
+	}
+	changed := p.selectActiveLocked()
+	p.mu.Unlock()
+	p.notify(changed)
+}
+
+func (p *mirrorPool) selectActiveLocked() bool {
+	for i, m := range p.mirrors {
+		if m.Healthy {
+			if i == p.active {
+				return false
+			}
+			p.active = i
+			return true
+		}
+	}
+	return false
+}
+
+func (p *mirrorPool) notify(changed bool) {
+	if !changed {
+		return
+	}
+	p.mu.RLock()
+	fn := p.onChange
+	var m DABMirror
+	if len(p.mirrors) > 0 {
+		m = p.mirrors[p.active]
+		m.Active = true
+	}
+	p.mu.RUnlock()
+	if fn != nil {
+		fn(m)
+	}
+}
+
+func (s *DABService) BaseURL() string {
+	return s.mirrors.current()
+}
+
+func (s *DABService) IsDABURL(rawURL string) bool {
+	_, ok := s.mirrors.owns(rawURL)
+	return ok
+}
+
+func (s *DABService) Mirrors() []DABMirror {
+	return s.mirrors.snapshot()
+}
+
+func (s *DABService) ActiveMirror() DABMirror {
+	for _, m := range s.mirrors.snapshot() {
+		if m.Active {
+			return m
+		}
+	}
+	return DABMirror{Base: s.BaseURL()}
+}
+
+func (s *DABService) ReloadMirrors() {
+	s.mirrors.reset(resolveDABAPIBases(s.config))
+	if err := s.ReloadTLS(); err != nil {
+		log.Printf("invalid DAB TLS settings, keeping previous client: %v", err)
+	}
+}
+
+func (s *DABService) OnMirrorChange(fn func(DABMirror)) {
+	s.mirrors.mu.Lock()
+	s.mirrors.onChange = fn
+	s.mirrors.mu.Unlock()
+}
+
+func (s *DABService) failover(rawURL string, reason string) (string, bool) {
+	base, ok := s.mirrors.owns(rawURL)
+	if !ok {
+		return rawURL, false
+	}
+	s.mirrors.markUnhealthy(base, reason)
+	next := s.mirrors.current()
+	if next == base {
+		return rawURL, false
+	}
+	return next + strings.TrimPrefix(rawURL, base), true
+}
+
+func (s *DABService) ProbeMirrors(ctx context.Context) {
+	for _, m := range s.mirrors.snapshot() {
+		healthy, latency, reason := s.probeMirror(ctx, m.Base)
+		s.mirrors.record(m.Base, healthy, latency, reason)
+	}
+}
+
+func (s *DABService) RunHealthProbe(ctx context.Context, interval time.Duration) {
+	s.ProbeMirrors(ctx)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.ProbeMirrors(ctx)
+		}
+	}
+}
+
+func (s *DABService) probeMirror(ctx context.Context, base string) (bool, time.Duration, string) {
+	ctx, cancel := context.WithTimeout(ctx, dabHealthProbeTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, "GET", base+"/auth/me", nil)
+	if err != nil {
+		return false, 0, err.Error()
+	}
+	s.setHeaders(req, "", false)
+
+	start := time.Now()
+	resp, err := s.HTTPClient().Do(req)
+	latency := time.Since(start)
+	if err != nil {
+		return false, latency, err.Error()
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 500 || resp.StatusCode == http.StatusForbidden {
+		return false, latency, statusError(resp).Error()
+	}
+	return true, latency, ""
+}
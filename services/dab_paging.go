@@ -0,0 +1,148 @@
+package services
+
+import (
+	"context"
+	"fmt"
+)
+
+const (
+	defaultTrackPageSize = 100
+	maxTrackPages        = 1000
+)
+
+type Pagination struct {
+	Page       int  `json:"page"`
+	Limit      int  `json:"limit"`
+	Total      int  `json:"total"`
+	TotalPages int  `json:"totalPages"`
+	HasMore    bool `json:"hasMore"`
+}
+
+type TrackPage struct {
+	Page    int        `json:"page"`
+	Limit   int        `json:"limit"`
+	Total   int        `json:"total"`
+	HasMore bool       `json:"hasMore"`
+	Tracks  []DABTrack `json:"tracks"`
+}
+
+type pagedLibraryWrapper struct {
+	Library struct {
+		LibraryDetailsResponse
+		Pagination *Pagination `json:"pagination"`
+	} `json:"library"`
+	Pagination *Pagination `json:"pagination"`
+}
+
+type pagedFavoritesResponse struct {
+	Favorites  []DABTrack  `json:"favorites"`
+	Pagination *Pagination `json:"pagination"`
+}
+
+func (s *DABService) IterateLibraryTracks(ctx context.Context, libraryID string, pageSize int, fn func(TrackPage) error) (*LibraryDetailsResponse, error) {
+	base := s.BaseURL()
+	delivered := false
+	details, err := s.iterateLibrary(ctx, fmt.Sprintf("%s/libraries/%s", base, libraryID), pageSize, func(p TrackPage) error {
+		delivered = true
+		return fn(p)
+	})
+	if err == nil {
+		return details, nil
+	}
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	if delivered {
+		return nil, fmt.Errorf("failed to fetch library details: %w", err)
+	}
+
+	details, errShared := s.iterateLibrary(ctx, fmt.Sprintf("%s/shared/library/%s", base, libraryID), pageSize, fn)
+	if errShared == nil {
+		return details, nil
+	}
+	return nil, fmt.Errorf("failed to fetch library details: %w (private), %w (shared)", err, errShared)
+}
+
+func (s *DABService) iterateLibrary(ctx context.Context, endpoint string, pageSize int, fn func(TrackPage) error) (*LibraryDetailsResponse, error) {
+	var details *LibraryDetailsResponse
+	err := s.iteratePages(ctx, pageSize, fn, func(page, limit int) ([]DABTrack, *Pagination, error) {
+		var result pagedLibraryWrapper
+		url := fmt.Sprintf("%s?page=%d&limit=%d", endpoint, page, limit)
+		if err := s.fetchJSONInto(ctx, url, &result); err != nil {
+			return nil, nil, err
+		}
+		if details == nil {
+			d := result.Library.LibraryDetailsResponse
+			d.Tracks = nil
+			details = &d
+		}
+		p := result.Library.Pagination
+		if p == nil {
+			p = result.Pagination
+		}
+		return result.Library.Tracks, p, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return details, nil
+}
+
+func (s *DABService) IterateFavorites(ctx context.Context, pageSize int, fn func(TrackPage) error) error {
+	base := s.BaseURL()
+	return s.iteratePages(ctx, pageSize, fn, func(page, limit int) ([]DABTrack, *Pagination, error) {
+		var result pagedFavoritesResponse
+		url := fmt.Sprintf("%s/favorites?page=%d&limit=%d", base, page, limit)
+		if err := s.fetchJSONInto(ctx, url, &result); err != nil {
+			return nil, nil, err
+		}
+		return result.Favorites, result.Pagination, nil
+	})
+}
+
+func (s *DABService) iteratePages(ctx context.Context, pageSize int, fn func(TrackPage) error, fetch func(page, limit int) ([]DABTrack, *Pagination, error)) error {
+	if pageSize <= 0 {
+		pageSize = defaultTrackPageSize
+	}
+
+	seen := make(map[string]struct{})
+	for page := 1; page <= maxTrackPages; page++ {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		tracks, p, err := fetch(page, pageSize)
+		if err != nil {
+			return err
+		}
+
+		fresh := tracks[:0:0]
+		for _, t := range tracks {
+			id := fmt.Sprintf("%v", t.ID)
+			if _, dup := seen[id]; dup {
+				continue
+			}
+			seen[id] = struct{}{}
+			fresh = append(fresh, t)
+		}
+
+		out := TrackPage{Page: page, Limit: pageSize, Tracks: fresh}
+		if p != nil {
+			out.Total = p.Total
+			out.HasMore = p.HasMore || (p.TotalPages > 0 && page < p.TotalPages)
+		} else {
+			out.HasMore = len(tracks) >= pageSize
+		}
+		if len(fresh) == 0 {
+			out.HasMore = false
+		}
+
+		if err := fn(out); err != nil {
+			return err
+		}
+		if !out.HasMore {
+			return nil
+		}
+	}
+	return nil
+}
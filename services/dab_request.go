@@ -0,0 +1,221 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	dabMaxRetries     = 4
+	dabBackoffBase    = 500 * time.Millisecond
+	dabBackoffMax     = 15 * time.Second
+	dabRetryAfterMax  = 60 * time.Second
+	dabErrorBodyLimit = 2048
+)
+
+func (s *DABService) execute(ctx context.Context, method, url string, body []byte) (*http.Response, error) {
+	token := s.TokenFor(url)
+	resp, err := s.executeWithToken(ctx, method, url, body, token)
+	if err != nil || resp.StatusCode != http.StatusUnauthorized || token == "" {
+		return resp, err
+	}
+	if s.config.DABEmail == "" || s.config.DABPassword == "" {
+		return resp, nil
+	}
+
+	io.Copy(io.Discard, io.LimitReader(resp.Body, dabErrorBodyLimit))
+	resp.Body.Close()
+
+	if err := s.reauthenticate(ctx, token); err != nil {
+		return nil, fmt.Errorf("%w: re-login failed: %v", ErrDABUnauthorized, err)
+	}
+	return s.executeWithToken(ctx, method, url, body, s.TokenFor(url))
+}
+
+func (s *DABService) executeWithToken(ctx context.Context, method, url string, body []byte, token string) (*http.Response, error) {
+	idempotent := isIdempotentMethod(method)
+
+	for attempt := 0; ; attempt++ {
+		if err := s.limiter.Wait(ctx); err != nil {
+			return nil, err
+		}
+
+		var reader io.Reader
+		if body != nil {
+			reader = bytes.NewReader(body)
+		}
+		req, err := http.NewRequestWithContext(ctx, method, url, reader)
+		if err != nil {
+			return nil, err
+		}
+		s.setHeaders(req, token, body != nil)
+
+		s.logRequest(req)
+		resp, err := s.HTTPClient().Do(req)
+		s.logResponse(resp, err)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			if !idempotent || attempt >= dabMaxRetries {
+				return nil, err
+			}
+			if next, ok := s.failover(url, err.Error()); ok {
+				url = next
+				if token != "" {
+					token = s.TokenFor(url)
+				}
+				continue
+			}
+			if err := sleepContext(ctx, backoffDelay(attempt, 0)); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		if idempotent && attempt < dabMaxRetries && isMirrorFailure(resp) {
+			reason := statusError(resp).Error()
+			resp.Body.Close()
+			if next, ok := s.failover(url, reason); ok {
+				url = next
+				if token != "" {
+					token = s.TokenFor(url)
+				}
+				continue
+			}
+			if err := sleepContext(ctx, backoffDelay(attempt, 0)); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		if attempt >= dabMaxRetries || !shouldRetryStatus(resp.StatusCode, idempotent) {
+			return resp, nil
+		}
+
+		wait := parseRetryAfter(resp.Header.Get("Retry-After"))
+		if resp.StatusCode == http.StatusTooManyRequests {
+			s.limiter.Throttle(wait)
+		}
+		io.Copy(io.Discard, io.LimitReader(resp.Body, dabErrorBodyLimit))
+		resp.Body.Close()
+		if err := sleepContext(ctx, backoffDelay(attempt, wait)); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (s *DABService) doJSON(ctx context.Context, method, url string, body []byte, target interface{}) error {
+	resp, err := s.execute(ctx, method, url, body)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return statusError(resp)
+	}
+	if target == nil {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(target)
+}
+
+func (s *DABService) setHeaders(req *http.Request, token string, hasBody bool) {
+	if hasBody {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	req.Header.Set("Accept", "application/json, text/plain, */*")
+	req.Header.Set("Accept-Language", "en-US,en;q=0.9")
+
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+		req.AddCookie(&http.Cookie{Name: "session", Value: token})
+	}
+
+	req.Header.Set("User-Agent", "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/131.0.0.0 Safari/537.36")
+	if origin := originFromBase(req.URL.String()); origin != "" {
+		req.Header.Set("Origin", origin)
+		req.Header.Set("Referer", origin+"/")
+	}
+	req.Header.Set("Sec-Ch-Ua", `"Google Chrome";v="131", "Chromium";v="131", "Not_A Brand";v="24"`)
+	req.Header.Set("Sec-Ch-Ua-Mobile", "?0")
+	req.Header.Set("Sec-Ch-Ua-Platform", `"Windows"`)
+	req.Header.Set("Sec-Fetch-Dest", "empty")
+	req.Header.Set("Sec-Fetch-Mode", "cors")
+	req.Header.Set("Sec-Fetch-Site", "same-origin")
+	req.Header.Set("Cache-Control", "no-cache")
+	req.Header.Set("Pragma", "no-cache")
+}
+
+func isMirrorFailure(resp *http.Response) bool {
+	switch resp.StatusCode {
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return strings.EqualFold(resp.Header.Get("Cf-Mitigated"), "challenge")
+}
+
+func isIdempotentMethod(method string) bool {
+	switch strings.ToUpper(method) {
+	case "GET", "HEAD", "OPTIONS", "PUT", "DELETE":
+		return true
+	}
+	return false
+}
+
+func shouldRetryStatus(status int, idempotent bool) bool {
+	if status == http.StatusTooManyRequests {
+		return true
+	}
+	if !idempotent {
+		return false
+	}
+	switch status {
+	case http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+func backoffDelay(attempt int, retryAfter time.Duration) time.Duration {
+	if retryAfter > 0 {
+		if retryAfter > dabRetryAfterMax {
+			retryAfter = dabRetryAfterMax
+		}
+		return retryAfter + rand.N(dabBackoffBase)
+	}
+	d := dabBackoffBase << attempt
+	if d <= 0 || d > dabBackoffMax {
+		d = dabBackoffMax
+	}
+	half := d / 2
+	return half + rand.N(half+1)
+}
+
+func parseRetryAfter(v string) time.Duration {
+	v = strings.TrimSpace(v)
+	if v == "" {
+		return 0
+	}
+	if secs, err := strconv.Atoi(v); err == nil {
+		if secs < 0 {
+			return 0
+		}
+		return time.Duration(secs) * time.Second
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		if d := time.Until(t); d > 0 {
+			return d
+		}
+	}
+	return 0
+}
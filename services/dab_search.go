@@ -0,0 +1,325 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+const (
+	SearchTypeTrack  = "track"
+	SearchTypeAlbum  = "album"
+	SearchTypeArtist = "artist"
+
+	maxFilteredSearchPages = 10
+)
+
+type SearchOptions struct {
+	Type   string `json:"type"`
+	Offset int    `json:"offset"`
+	Limit  int    `json:"limit"`
+}
+
+type SearchFilters struct {
+	Type      string `json:"type"`
+	Offset    int    `json:"offset"`
+	Limit     int    `json:"limit"`
+	HiResOnly bool   `json:"hiResOnly"`
+	YearFrom  int    `json:"yearFrom"`
+	YearTo    int    `json:"yearTo"`
+	Genre     string `json:"genre"`
+}
+
+type SearchResponse struct {
+	Tracks     []DABTrack  `json:"tracks"`
+	Albums     []DABAlbum  `json:"albums"`
+	Artists    []DABArtist `json:"artists"`
+	Total      int         `json:"total"`
+	Pagination *struct {
+		Offset  int  `json:"offset"`
+		Limit   int  `json:"limit"`
+		Total   int  `json:"total"`
+		HasMore bool `json:"hasMore"`
+	} `json:"pagination"`
+}
+
+type SearchResult struct {
+	Query   string      `json:"query"`
+	Type    string      `json:"type"`
+	Offset  int         `json:"offset"`
+	Limit   int         `json:"limit"`
+	Total   int         `json:"total"`
+	HasMore bool        `json:"hasMore"`
+	Tracks  []DABTrack  `json:"tracks"`
+	Albums  []DABAlbum  `json:"albums"`
+	Artists []DABArtist `json:"artists"`
+
+	// NextOffset is the upstream offset to request next; filtered searches
+	// may consume more than Limit upstream results per page.
+	NextOffset int `json:"nextOffset,omitempty"`
+}
+
+func (s *DABService) Search(query string) ([]DABTrack, error) {
+	return s.SearchContext(context.Background(), query)
+}
+
+func (s *DABService) SearchContext(ctx context.Context, query string) ([]DABTrack, error) {
+	result, err := s.SearchPageContext(ctx, query, SearchOptions{Type: SearchTypeTrack})
+	if err != nil {
+		return nil, err
+	}
+	return result.Tracks, nil
+}
+
+func (s *DABService) SearchByISRCContext(ctx context.Context, isrc string) ([]DABTrack, error) {
+	isrc = normalizeISRC(isrc)
+	if isrc == "" {
+		return []DABTrack{}, nil
+	}
+	tracks, err := s.SearchContext(ctx, isrc)
+	if err != nil {
+		return nil, err
+	}
+	matches := tracks[:0:0]
+	for _, t := range tracks {
+		if normalizeISRC(t.ISRC) == isrc {
+			matches = append(matches, t)
+		}
+	}
+	return matches, nil
+}
+
+func (s *DABService) SearchPageContext(ctx context.Context, query string, opts SearchOptions) (*SearchResult, error) {
+	searchType := strings.ToLower(strings.TrimSpace(opts.Type))
+	switch searchType {
+	case "":
+		searchType = SearchTypeTrack
+	case SearchTypeTrack, SearchTypeAlbum, SearchTypeArtist:
+	default:
+		return nil, fmt.Errorf("unsupported search type %q", opts.Type)
+	}
+
+	u, err := url.Parse(s.BaseURL() + "/search")
+	if err != nil {
+		return nil, err
+	}
+
+	q := u.Query()
+	q.Set("q", query)
+	q.Set("type", searchType)
+	if opts.Offset > 0 {
+		q.Set("offset", strconv.Itoa(opts.Offset))
+	}
+	if opts.Limit > 0 {
+		q.Set("limit", strconv.Itoa(opts.Limit))
+	}
+	u.RawQuery = q.Encode()
+
+	resp, err := s.execute(ctx, "GET", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("search failed: %w", statusError(resp))
+	}
+
+	var body bytes.Buffer
+	if _, err := body.ReadFrom(resp.Body); err != nil {
+		return nil, err
+	}
+
+	result, err := parseSearchResponse(body.Bytes(), searchType)
+	if err != nil {
+		return nil, fmt.Errorf("search failed: %w", err)
+	}
+	result.Query = query
+	result.Offset = opts.Offset
+	result.Limit = opts.Limit
+	if result.Total < result.Offset+result.count() {
+		result.Total = result.Offset + result.count()
+	}
+	return result, nil
+}
+
+func parseSearchResponse(data []byte, searchType string) (*SearchResult, error) {
+	result := &SearchResult{
+		Type:    searchType,
+		Tracks:  []DABTrack{},
+		Albums:  []DABAlbum{},
+		Artists: []DABArtist{},
+	}
+
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) > 0 && trimmed[0] == '[' {
+		var err error
+		switch searchType {
+		case SearchTypeAlbum:
+			err = json.Unmarshal(trimmed, &result.Albums)
+		case SearchTypeArtist:
+			err = json.Unmarshal(trimmed, &result.Artists)
+		default:
+			err = json.Unmarshal(trimmed, &result.Tracks)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("unexpected search response: %w", err)
+		}
+		result.Total = result.count()
+		return result, nil
+	}
+
+	var keys map[string]json.RawMessage
+	if err := json.Unmarshal(trimmed, &keys); err != nil {
+		return nil, fmt.Errorf("unexpected search response: %w", err)
+	}
+	_, hasTracks := keys["tracks"]
+	_, hasAlbums := keys["albums"]
+	_, hasArtists := keys["artists"]
+	if !hasTracks && !hasAlbums && !hasArtists {
+		return nil, fmt.Errorf("unexpected search response: no tracks, albums or artists")
+	}
+
+	var raw SearchResponse
+	if err := json.Unmarshal(trimmed, &raw); err != nil {
+		return nil, fmt.Errorf("unexpected search response: %w", err)
+	}
+	if raw.Tracks != nil {
+		result.Tracks = raw.Tracks
+	}
+	if raw.Albums != nil {
+		result.Albums = raw.Albums
+	}
+	if raw.Artists != nil {
+		result.Artists = raw.Artists
+	}
+	result.Total = raw.Total
+	if raw.Pagination != nil {
+		if raw.Pagination.Total > 0 {
+			result.Total = raw.Pagination.Total
+		}
+		result.HasMore = raw.Pagination.HasMore
+	}
+	return result, nil
+}
+
+func (r *SearchResult) count() int {
+	switch r.Type {
+	case SearchTypeAlbum:
+		return len(r.Albums)
+	case SearchTypeArtist:
+		return len(r.Artists)
+	}
+	return len(r.Tracks)
+}
+
+func (s *DABService) SearchAdvancedContext(ctx context.Context, query string, filters SearchFilters) (*SearchResult, error) {
+	// Artists carry no quality, release date or genre to filter on.
+	if filters.Type == SearchTypeArtist && filters.active() {
+		return nil, fmt.Errorf("search filters do not apply to artist searches")
+	}
+	opts := SearchOptions{Type: filters.Type, Offset: filters.Offset, Limit: filters.Limit}
+	result, err := s.SearchPageContext(ctx, query, opts)
+	if err != nil {
+		return nil, err
+	}
+	result.HasMore = result.hasMoreUpstream()
+	result.NextOffset = result.Offset + result.count()
+	if !filters.active() {
+		return result, nil
+	}
+
+	limit := filters.Limit
+	if limit <= 0 {
+		limit = result.count()
+	}
+	result.filter(filters)
+	for page := 1; page < maxFilteredSearchPages && result.HasMore && result.count() < limit; page++ {
+		opts.Offset = result.NextOffset
+		next, err := s.SearchPageContext(ctx, query, opts)
+		if err != nil {
+			return nil, err
+		}
+		if next.count() == 0 {
+			result.HasMore = false
+			break
+		}
+		result.HasMore = next.hasMoreUpstream()
+		result.NextOffset = next.Offset + next.count()
+		next.filter(filters)
+		result.Tracks = append(result.Tracks, next.Tracks...)
+		result.Albums = append(result.Albums, next.Albums...)
+	}
+	result.Total = result.count()
+	return result, nil
+}
+
+func (r *SearchResult) hasMoreUpstream() bool {
+	return r.HasMore || r.Total > r.Offset+r.count()
+}
+
+func (r *SearchResult) filter(filters SearchFilters) {
+	tracks := r.Tracks[:0:0]
+	for _, t := range r.Tracks {
+		if filters.matches(t.AudioQuality, t.ReleaseDate, t.Genre) {
+			tracks = append(tracks, t)
+		}
+	}
+	r.Tracks = tracks
+
+	albums := r.Albums[:0:0]
+	for _, a := range r.Albums {
+		if filters.matches(a.AudioQuality, a.ReleaseDate, a.Genre) {
+			albums = append(albums, a)
+		}
+	}
+	r.Albums = albums
+}
+
+func (f SearchFilters) active() bool {
+	return f.HiResOnly || f.YearFrom > 0 || f.YearTo > 0 || strings.TrimSpace(f.Genre) != ""
+}
+
+func (f SearchFilters) matches(q AudioQuality, releaseDate, genre string) bool {
+	if f.HiResOnly && !isHiRes(q) {
+		return false
+	}
+	if f.YearFrom > 0 || f.YearTo > 0 {
+		year := releaseYear(releaseDate)
+		if year == 0 {
+			return false
+		}
+		if f.YearFrom > 0 && year < f.YearFrom {
+			return false
+		}
+		if f.YearTo > 0 && year > f.YearTo {
+			return false
+		}
+	}
+	if g := strings.TrimSpace(f.Genre); g != "" {
+		if !strings.Contains(strings.ToLower(genre), strings.ToLower(g)) {
+			return false
+		}
+	}
+	return true
+}
+
+func isHiRes(q AudioQuality) bool {
+	return q.IsHiRes || q.MaxBitDepth > 16 || q.MaxSamplingRate > 48
+}
+
+func releaseYear(date string) int {
+	date = strings.TrimSpace(date)
+	if len(date) < 4 {
+		return 0
+	}
+	year, err := strconv.Atoi(date[:4])
+	if err != nil {
+		return 0
+	}
+	return year
+}
@@ -1,23 +1,17 @@
 package services
 
 import (
+	"context"
 	"fmt"
-	"net/http"
 )
 
 func (s *DABService) VerifyToken(token string) bool {
-	url := fmt.Sprintf("%s/auth/me", resolveDABAPIBase(s.config))
-	req, err := http.NewRequest("GET", url, nil)
-	if err != nil {
-		return false
-	}
-
-	req.Header.Set("User-Agent", "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/1337.0.0.0 Safari/537.36")
-	req.Header.Set("Authorization", "Bearer "+token)
-
-	req.AddCookie(&http.Cookie{Name: "session", Value: token})
+	return s.VerifyTokenContext(context.Background(), token)
+}
 
-	resp, err := s.client.Do(req)
+func (s *DABService) VerifyTokenContext(ctx context.Context, token string) bool {
+	url := fmt.Sprintf("%s/auth/me", s.authBase())
+	resp, err := s.executeWithToken(ctx, "GET", url, nil, token)
 	if err != nil {
 		return false
 	}
@@ -1,6 +1,7 @@
 package services
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -149,15 +150,15 @@ func (s *DownloadService) downloadTrack(item *DownloadItem) {
 	}
 	defer out.Close()
 
-	client := &http.Client{}
+	client := s.dabService.HTTPClient()
 	setHeaders := func(r *http.Request) {
 		r.Header.Set("User-Agent", "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/1337.0.0.0 Safari/537.36")
 		if strings.Contains(streamURL, "youtube.com") || strings.Contains(streamURL, "googlevideo.com") {
 			r.Header.Set("Referer", "https://music.youtube.com/")
 			r.Header.Set("Origin", "https://music.youtube.com")
 		}
-		if strings.Contains(streamURL, resolveDABAPIBase(s.config)) && s.config.DABAuthToken != "" {
-			r.AddCookie(&http.Cookie{Name: "session", Value: s.config.DABAuthToken})
+		if token := s.dabService.TokenFor(streamURL); token != "" {
+			r.AddCookie(&http.Cookie{Name: "session", Value: token})
 		}
 	}
 
@@ -174,6 +175,13 @@ func (s *DownloadService) downloadTrack(item *DownloadItem) {
 		}
 	}
 
+	viaDAB := s.dabService.IsDABURL(streamURL)
+	if viaDAB {
+		if err := s.dabService.Limiter().Wait(context.Background()); err != nil {
+			s.failDownload(item, err.Error())
+			return
+		}
+	}
 	req, err := http.NewRequest("GET", streamURL, nil)
 	if err != nil {
 		s.failDownload(item, err.Error())
@@ -189,6 +197,9 @@ func (s *DownloadService) downloadTrack(item *DownloadItem) {
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusPartialContent {
+		if viaDAB && resp.StatusCode == http.StatusTooManyRequests {
+			s.dabService.Limiter().Throttle(parseRetryAfter(resp.Header.Get("Retry-After")))
+		}
 		s.failDownload(item, fmt.Sprintf("HTTP %d", resp.StatusCode))
 		return
 	}
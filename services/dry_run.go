@@ -0,0 +1,85 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+type DryRunTrack struct {
+	Index     int       `json:"index"`
+	Source    TrackInfo `json:"source"`
+	Status    string    `json:"status"`
+	Best      *DABTrack `json:"best,omitempty"`
+	Score     int       `json:"score"`
+	Matched   bool      `json:"matched"`
+	Query     string    `json:"query,omitempty"`
+	Via       string    `json:"via,omitempty"`
+	Breakdown string    `json:"breakdown,omitempty"`
+	Error     string    `json:"error,omitempty"`
+}
+
+type DryRunReport struct {
+	Total       int           `json:"total"`
+	Matched     int           `json:"matched"`
+	Review      int           `json:"review"`
+	NotFound    int           `json:"notFound"`
+	Failed      int           `json:"failed"`
+	Cancelled   int           `json:"cancelled"`
+	MatchRate   float64       `json:"matchRate"`
+	GeneratedAt time.Time     `json:"generatedAt"`
+	Tracks      []DryRunTrack `json:"tracks"`
+}
+
+func (s *DABService) DryRunContext(ctx context.Context, tracks []TrackInfo, onProgress func(string), onTrackStatus func(int, string, string)) *DryRunReport {
+	matches := s.matchTracks(ctx, s.matchStore.Snapshot(), tracks, onProgress, onTrackStatus)
+	report := NewDryRunReport(matches)
+	onProgress(fmt.Sprintf("Dry run complete: %d/%d matched, %d need review, %d not found, %d failed", report.Matched, report.Total, report.Review, report.NotFound, report.Failed))
+	return report
+}
+
+func NewDryRunReport(matches []TrackMatch) *DryRunReport {
+	report := &DryRunReport{
+		Total:       len(matches),
+		GeneratedAt: time.Now(),
+		Tracks:      make([]DryRunTrack, 0, len(matches)),
+	}
+	for _, m := range matches {
+		entry := DryRunTrack{
+			Index:     m.Index,
+			Source:    m.Source,
+			Status:    m.Status,
+			Score:     m.Score,
+			Matched:   m.Track != nil,
+			Query:     m.Query,
+			Via:       m.Via,
+			Breakdown: m.Breakdown,
+			Error:     m.Error,
+		}
+		if m.Track != nil {
+			best := *m.Track
+			entry.Best = &best
+		} else if len(m.Candidates) > 0 {
+			best := m.Candidates[0].Track
+			entry.Best = &best
+		}
+
+		switch m.Status {
+		case MatchStatusMatched:
+			report.Matched++
+		case MatchStatusReview:
+			report.Review++
+		case MatchStatusNotFound:
+			report.NotFound++
+		case MatchStatusCancelled:
+			report.Cancelled++
+		default:
+			report.Failed++
+		}
+		report.Tracks = append(report.Tracks, entry)
+	}
+	if report.Total > 0 {
+		report.MatchRate = float64(report.Matched) / float64(report.Total)
+	}
+	return report
+}
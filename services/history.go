@@ -3,29 +3,97 @@ package services
 import (
 	"encoding/json"
 	"fmt"
+	"math/rand/v2"
 	"os"
 	"path/filepath"
+	"sync"
 	"time"
 )
 
+const (
+	TransferStatusRunning     = "running"
+	TransferStatusCompleted   = "completed"
+	TransferStatusFailed      = "failed"
+	TransferStatusCancelled   = "cancelled"
+	TransferStatusInterrupted = "interrupted"
+)
+
+const (
+	TrackOutcomeAdded     = "added"
+	TrackOutcomeExisting  = "existing"
+	TrackOutcomeFailed    = "failed"
+	TrackOutcomeNotFound  = "not-found"
+	TrackOutcomeCancelled = "cancelled"
+)
+
+type TransferTrack struct {
+	Source  TrackInfo `json:"source"`
+	TrackID string    `json:"trackId,omitempty"`
+	Score   int       `json:"score"`
+	Status  string    `json:"status"`
+	Error   string    `json:"error,omitempty"`
+}
+
+func newTransferTrack(m TrackMatch) TransferTrack {
+	out := TransferTrack{Source: m.Source, Score: m.Score, Error: m.Error}
+	switch {
+	case m.Track != nil:
+		out.TrackID = trackIDString(m.Track.ID)
+		out.Status = TrackOutcomeCancelled
+	case m.Status == MatchStatusCancelled:
+		out.Status = TrackOutcomeCancelled
+	case m.Status == MatchStatusError:
+		out.Status = TrackOutcomeFailed
+	default:
+		out.Status = TrackOutcomeNotFound
+	}
+	return out
+}
+
+func (t TransferTrack) Retryable() bool {
+	return t.Status == TrackOutcomeFailed || t.Status == TrackOutcomeNotFound || t.Status == TrackOutcomeCancelled
+}
+
 type TransferRecord struct {
-	ID            string `json:"id"`
-	PlaylistName  string `json:"playlistName"`
-	SourceURL     string `json:"sourceURL"`
-	TotalTracks   int    `json:"totalTracks"`
-	MatchedTracks int    `json:"matchedTracks"`
-	AddedTracks   int    `json:"addedTracks"`
-	FailedTracks  int    `json:"failedTracks"`
-	Status        string `json:"status"`
-	CreatedAt     string `json:"createdAt"`
-	CompletedAt   string `json:"completedAt"`
-	LibraryID     string `json:"libraryID"`
-	ErrorMessage  string `json:"errorMessage,omitempty"`
-	Duration      int    `json:"duration"`
-	Source        string `json:"source"`
+	ID            string          `json:"id"`
+	PlaylistName  string          `json:"playlistName"`
+	SourceURL     string          `json:"sourceURL"`
+	TotalTracks   int             `json:"totalTracks"`
+	MatchedTracks int             `json:"matchedTracks"`
+	AddedTracks   int             `json:"addedTracks"`
+	FailedTracks  int             `json:"failedTracks"`
+	Status        string          `json:"status"`
+	CreatedAt     string          `json:"createdAt"`
+	CompletedAt   string          `json:"completedAt"`
+	LibraryID     string          `json:"libraryID"`
+	ErrorMessage  string          `json:"errorMessage,omitempty"`
+	Duration      int             `json:"duration"`
+	Source        string          `json:"source"`
+	Mode          string          `json:"mode,omitempty"`
+	RemovedTracks int             `json:"removedTracks,omitempty"`
+	Tracks        []TransferTrack `json:"tracks,omitempty"`
+}
+
+func (r *TransferRecord) Recount() {
+	if len(r.Tracks) == 0 {
+		return
+	}
+	r.MatchedTracks, r.AddedTracks, r.FailedTracks = 0, 0, 0
+	for _, t := range r.Tracks {
+		if t.TrackID != "" {
+			r.MatchedTracks++
+		}
+		switch t.Status {
+		case TrackOutcomeAdded:
+			r.AddedTracks++
+		case TrackOutcomeFailed, TrackOutcomeNotFound:
+			r.FailedTracks++
+		}
+	}
 }
 
 type HistoryManager struct {
+	mu          sync.Mutex
 	historyFile string
 }
 
@@ -40,11 +108,20 @@ func NewHistoryManager() (*HistoryManager, error) {
 	return &HistoryManager{historyFile: historyFile}, nil
 }
 
+// NewTransferID adds a random suffix to the timestamp so transfers started
+// in the same millisecond, such as watched playlists, get distinct IDs.
+func NewTransferID() string {
+	return fmt.Sprintf("transfer_%d_%06x", time.Now().UnixMilli(), rand.IntN(1<<24))
+}
+
 func (hm *HistoryManager) AddRecord(record TransferRecord) error {
+	hm.mu.Lock()
+	defer hm.mu.Unlock()
+
 	records, _ := hm.LoadRecords()
 
 	if record.ID == "" {
-		record.ID = fmt.Sprintf("transfer_%d", time.Now().UnixMilli())
+		record.ID = NewTransferID()
 	}
 	if record.CreatedAt == "" {
 		record.CreatedAt = time.Now().Format(time.RFC3339)
@@ -80,7 +157,54 @@ func (hm *HistoryManager) LoadRecords() ([]TransferRecord, error) {
 	return records, nil
 }
 
+func (hm *HistoryManager) GetRecord(id string) (*TransferRecord, error) {
+	records, err := hm.LoadRecords()
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range records {
+		if r.ID == id {
+			return &r, nil
+		}
+	}
+	return nil, fmt.Errorf("transfer record %s not found", id)
+}
+
+func (hm *HistoryManager) ReplaceRecord(record TransferRecord) error {
+	hm.mu.Lock()
+	defer hm.mu.Unlock()
+
+	records, err := hm.LoadRecords()
+	if err != nil {
+		return err
+	}
+
+	found := false
+	for i, r := range records {
+		if r.ID == record.ID {
+			records[i] = record
+			found = true
+			break
+		}
+	}
+	if !found {
+		return fmt.Errorf("transfer record %s not found", record.ID)
+	}
+
+	data, err := json.MarshalIndent(records, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(hm.historyFile), 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(hm.historyFile, data, 0644)
+}
+
 func (hm *HistoryManager) ClearHistory() error {
+	hm.mu.Lock()
+	defer hm.mu.Unlock()
+
 	data, _ := json.MarshalIndent([]TransferRecord{}, "", "  ")
 	if err := os.MkdirAll(filepath.Dir(hm.historyFile), 0755); err != nil {
 		return err
@@ -89,6 +213,9 @@ func (hm *HistoryManager) ClearHistory() error {
 }
 
 func (hm *HistoryManager) UpdateRecord(id string, updates map[string]interface{}) error {
+	hm.mu.Lock()
+	defer hm.mu.Unlock()
+
 	records, err := hm.LoadRecords()
 	if err != nil {
 		return err
@@ -102,6 +229,12 @@ func (hm *HistoryManager) UpdateRecord(id string, updates map[string]interface{}
 			if libraryID, ok := updates["libraryID"].(string); ok {
 				records[i].LibraryID = libraryID
 			}
+			if totalTracks, ok := updates["totalTracks"].(int); ok {
+				records[i].TotalTracks = totalTracks
+			}
+			if matchedTracks, ok := updates["matchedTracks"].(int); ok {
+				records[i].MatchedTracks = matchedTracks
+			}
 			if addedTracks, ok := updates["addedTracks"].(int); ok {
 				records[i].AddedTracks = addedTracks
 			}
@@ -111,10 +244,16 @@ func (hm *HistoryManager) UpdateRecord(id string, updates map[string]interface{}
 			if errorMsg, ok := updates["errorMessage"].(string); ok {
 				records[i].ErrorMessage = errorMsg
 			}
+			if tracks, ok := updates["tracks"].([]TransferTrack); ok {
+				records[i].Tracks = tracks
+			}
 			if completedAt, ok := updates["completedAt"].(time.Time); ok {
 				records[i].CompletedAt = completedAt.Format(time.RFC3339)
-				created, _ := time.Parse(time.RFC3339, records[i].CreatedAt)
-				records[i].Duration = int(completedAt.Sub(created).Seconds())
+			}
+			// Each run adds its own time, so a resumed transfer does not
+			// count the time it spent interrupted.
+			if run, ok := updates["runDuration"].(time.Duration); ok {
+				records[i].Duration += int(run.Seconds())
 			}
 			break
 		}
@@ -130,7 +269,38 @@ func (hm *HistoryManager) UpdateRecord(id string, updates map[string]interface{}
 	return os.WriteFile(hm.historyFile, data, 0644)
 }
 
+func (hm *HistoryManager) MarkInterrupted() error {
+	hm.mu.Lock()
+	defer hm.mu.Unlock()
+
+	records, err := hm.LoadRecords()
+	if err != nil {
+		return err
+	}
+
+	changed := false
+	for i, r := range records {
+		if r.Status == TransferStatusRunning {
+			records[i].Status = TransferStatusInterrupted
+			records[i].ErrorMessage = "interrupted before completion"
+			changed = true
+		}
+	}
+	if !changed {
+		return nil
+	}
+
+	data, err := json.MarshalIndent(records, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(hm.historyFile, data, 0644)
+}
+
 func (hm *HistoryManager) DeleteRecord(id string) error {
+	hm.mu.Lock()
+	defer hm.mu.Unlock()
+
 	records, err := hm.LoadRecords()
 	if err != nil {
 		return err
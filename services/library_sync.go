@@ -0,0 +1,341 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+)
+
+type SyncOptions struct {
+	RemoveMissing bool `json:"removeMissing"`
+}
+
+type SyncResult struct {
+	LibraryID       string          `json:"libraryId"`
+	LibraryName     string          `json:"libraryName"`
+	SourceTotal     int             `json:"sourceTotal"`
+	LibraryTotal    int             `json:"libraryTotal"`
+	Unchanged       int             `json:"unchanged"`
+	Matched         int             `json:"matched"`
+	Added           int             `json:"added"`
+	Removed         int             `json:"removed"`
+	Failed          int             `json:"failed"`
+	Cancelled       int             `json:"cancelled"`
+	AddedTracks     []DABTrack      `json:"addedTracks"`
+	RemovedTracks   []DABTrack      `json:"removedTracks"`
+	UnmatchedTracks []TrackInfo     `json:"unmatchedTracks"`
+	Tracks          []TransferTrack `json:"tracks"`
+}
+
+func (s *DABService) SyncLibrary(libraryID string, tracks []TrackInfo, opts SyncOptions, onProgress func(string), onTrackStatus func(int, string, string)) (*SyncResult, error) {
+	return s.SyncLibraryContext(context.Background(), libraryID, tracks, opts, onProgress, onTrackStatus)
+}
+
+func (s *DABService) SyncLibraryContext(ctx context.Context, libraryID string, tracks []TrackInfo, opts SyncOptions, onProgress func(string), onTrackStatus func(int, string, string)) (*SyncResult, error) {
+	if s.AuthToken() == "" {
+		return nil, fmt.Errorf("not logged in to DAB")
+	}
+
+	onProgress("Loading library contents...")
+	details, err := s.GetLibraryDetailsContext(ctx, libraryID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load library: %w", err)
+	}
+
+	result := &SyncResult{
+		LibraryID:       libraryID,
+		LibraryName:     details.Name,
+		SourceTotal:     len(tracks),
+		LibraryTotal:    len(details.Tracks),
+		AddedTracks:     []DABTrack{},
+		RemovedTracks:   []DABTrack{},
+		UnmatchedTracks: []TrackInfo{},
+		Tracks:          make([]TransferTrack, len(tracks)),
+	}
+	for i, t := range tracks {
+		result.Tracks[i] = TransferTrack{Source: t, Status: TrackOutcomeCancelled}
+	}
+	markExisting := func(i int, id string, score int) {
+		result.Unchanged++
+		result.Tracks[i].TrackID = id
+		result.Tracks[i].Score = score
+		result.Tracks[i].Status = TrackOutcomeExisting
+		onTrackStatus(i, "synced", "")
+	}
+
+	existing := make(map[string]DABTrack, len(details.Tracks))
+	for _, t := range details.Tracks {
+		existing[trackIDString(t.ID)] = t
+	}
+	index := newLibraryIndex(details.Tracks)
+	claimed := make(map[string]bool)
+	claim := func(i int, track DABTrack, score int) bool {
+		id := trackIDString(track.ID)
+		if claimed[id] {
+			return false
+		}
+		claimed[id] = true
+		markExisting(i, id, score)
+		return true
+	}
+
+	var pending []int
+	for i, t := range tracks {
+		if err := ctx.Err(); err != nil {
+			result.Cancelled = len(tracks) - result.Unchanged
+			onProgress("Sync cancelled.")
+			return result, err
+		}
+		if cached, ok := s.matchStore.Lookup(t); ok {
+			if track, inLibrary := existing[trackIDString(cached.Track.ID)]; inLibrary && claim(i, track, cached.Score) {
+				continue
+			}
+		}
+		if isrc := normalizeISRC(t.ISRC); isrc != "" {
+			found := false
+			for _, track := range index.byISRC[isrc] {
+				if found = claim(i, track, 100); found {
+					break
+				}
+			}
+			if found {
+				continue
+			}
+		}
+		if candidates := index.candidates(t); len(candidates) > 0 {
+			if res := s.matcher.Match(t, candidates); res.Matched && claim(i, *res.Track, res.Score) {
+				continue
+			}
+		}
+		pending = append(pending, i)
+	}
+	onProgress(fmt.Sprintf("%d tracks already in '%s', %d to match...", result.Unchanged, details.Name, len(pending)))
+
+	var toAdd []TrackMatch
+	matchErrors := 0
+	if len(pending) > 0 {
+		sources := make([]TrackInfo, len(pending))
+		for i, idx := range pending {
+			sources[i] = tracks[idx]
+		}
+		matches := s.MatchTracksContext(ctx, sources, onProgress, func(i int, status, errorMsg string) {
+			onTrackStatus(pending[i], status, errorMsg)
+		})
+		for i, m := range matches {
+			m.Index = pending[i]
+			result.Tracks[m.Index] = newTransferTrack(m)
+			switch {
+			case m.Track != nil:
+				id := trackIDString(m.Track.ID)
+				if claimed[id] {
+					markExisting(m.Index, id, m.Score)
+					continue
+				}
+				claimed[id] = true
+				if _, inLibrary := existing[id]; inLibrary {
+					markExisting(m.Index, id, m.Score)
+					continue
+				}
+				toAdd = append(toAdd, m)
+			case m.Status == MatchStatusCancelled:
+				result.Cancelled++
+			case m.Status == MatchStatusError:
+				matchErrors++
+				result.UnmatchedTracks = append(result.UnmatchedTracks, m.Source)
+			default:
+				result.UnmatchedTracks = append(result.UnmatchedTracks, m.Source)
+			}
+		}
+	}
+	result.Matched = result.Unchanged + len(toAdd)
+	result.Failed = len(result.UnmatchedTracks)
+
+	if ctx.Err() != nil {
+		result.Cancelled += len(toAdd)
+		onProgress("Sync cancelled.")
+		return result, ctx.Err()
+	}
+
+	if len(toAdd) > 0 {
+		onProgress(fmt.Sprintf("Adding %d new tracks...", len(toAdd)))
+		var mu sync.Mutex
+		addedIdx := make(map[int]bool)
+		added := s.AddMatchesContext(ctx, libraryID, NewLibraryMembership(details.Tracks), toAdd, onProgress, func(i int, status, errorMsg string) {
+			mu.Lock()
+			switch status {
+			case "added":
+				addedIdx[i] = true
+				result.Tracks[i].Status = TrackOutcomeAdded
+			case "error":
+				result.Tracks[i].Status = TrackOutcomeFailed
+				result.Tracks[i].Error = errorMsg
+			}
+			mu.Unlock()
+			onTrackStatus(i, status, errorMsg)
+		})
+		result.Added = added.Added
+		result.Failed += added.Failed
+		result.Cancelled += added.Cancelled
+		for _, m := range toAdd {
+			if addedIdx[m.Index] {
+				result.AddedTracks = append(result.AddedTracks, *m.Track)
+			}
+		}
+	}
+
+	if opts.RemoveMissing {
+		skipRemovals := func(reason string) {
+			onProgress(fmt.Sprintf("ℹ Skipping removals because %s.", reason))
+			log.Printf("sync of library %s: skipping removals because %s", libraryID, reason)
+		}
+		switch {
+		case ctx.Err() != nil:
+		case result.Cancelled > 0:
+			skipRemovals("matching did not finish")
+		case matchErrors > 0:
+			skipRemovals(fmt.Sprintf("%d tracks could not be searched", matchErrors))
+		case result.Failed > 0:
+			skipRemovals(fmt.Sprintf("%d tracks were not matched or added", result.Failed))
+		default:
+			for _, t := range details.Tracks {
+				id := trackIDString(t.ID)
+				if claimed[id] {
+					continue
+				}
+				if err := s.RemoveTrackFromLibraryContext(ctx, libraryID, id); err != nil {
+					if ctx.Err() != nil {
+						break
+					}
+					onProgress(fmt.Sprintf("✗ Failed to remove '%s': %v", t.Title, err))
+					result.Failed++
+					continue
+				}
+				onProgress(fmt.Sprintf("− Removed '%s - %s'", t.Artist, t.Title))
+				result.Removed++
+				result.RemovedTracks = append(result.RemovedTracks, t)
+			}
+		}
+	}
+
+	if ctx.Err() != nil {
+		onProgress(fmt.Sprintf("Sync cancelled. %d added, %d removed before stopping.", result.Added, result.Removed))
+		return result, ctx.Err()
+	}
+
+	onProgress(fmt.Sprintf("Sync complete: %d unchanged, %d added, %d removed, %d unmatched.", result.Unchanged, result.Added, result.Removed, len(result.UnmatchedTracks)))
+	return result, nil
+}
+
+type libraryIndex struct {
+	tracks  []DABTrack
+	byISRC  map[string][]DABTrack
+	byToken map[string][]int
+}
+
+func newLibraryIndex(tracks []DABTrack) *libraryIndex {
+	ix := &libraryIndex{
+		tracks:  tracks,
+		byISRC:  make(map[string][]DABTrack),
+		byToken: make(map[string][]int),
+	}
+	for i, t := range tracks {
+		if isrc := normalizeISRC(t.ISRC); isrc != "" {
+			ix.byISRC[isrc] = append(ix.byISRC[isrc], t)
+		}
+		seen := make(map[string]bool)
+		for _, tok := range strings.Fields(normalizeString(t.Title)) {
+			if !seen[tok] {
+				seen[tok] = true
+				ix.byToken[tok] = append(ix.byToken[tok], i)
+			}
+		}
+	}
+	return ix
+}
+
+// candidates narrows the library to tracks sharing the rarest title token
+// with the source, so only a handful are scored instead of the whole library.
+func (ix *libraryIndex) candidates(t TrackInfo) []DABTrack {
+	var best []int
+	for _, tok := range strings.Fields(normalizeString(t.Title)) {
+		if posting, ok := ix.byToken[tok]; ok && (best == nil || len(posting) < len(best)) {
+			best = posting
+		}
+	}
+	out := make([]DABTrack, len(best))
+	for i, idx := range best {
+		out[i] = ix.tracks[idx]
+	}
+	return out
+}
+
+func (s *DABService) RetryTransferContext(ctx context.Context, record *TransferRecord, onProgress func(string), onTrackStatus func(int, string, string)) error {
+	if record.LibraryID == "" {
+		return fmt.Errorf("transfer %s has no library to retry into", record.ID)
+	}
+
+	var indices []int
+	var sources []TrackInfo
+	for i, t := range record.Tracks {
+		if t.Retryable() {
+			indices = append(indices, i)
+			sources = append(sources, t.Source)
+		}
+	}
+	if len(indices) == 0 {
+		return fmt.Errorf("transfer %s has no failed tracks to retry", record.ID)
+	}
+
+	onProgress(fmt.Sprintf("Retrying %d tracks from '%s'...", len(indices), record.PlaylistName))
+	matches := s.MatchTracksContext(ctx, sources, onProgress, func(i int, status, errorMsg string) {
+		onTrackStatus(indices[i], status, errorMsg)
+	})
+	retried := make([]TransferTrack, len(matches))
+	for i, m := range matches {
+		retried[i] = newTransferTrack(m)
+	}
+
+	// Retried entries are matched and added on their own, like a fresh
+	// conversion, so they can never claim a library track that another
+	// entry of this playlist already put there.
+	err := ctx.Err()
+	if err == nil {
+		var members *LibraryMembership
+		members, err = s.LoadLibraryMembership(ctx, record.LibraryID)
+		if err != nil {
+			for i := range retried {
+				if matches[i].Track != nil {
+					retried[i].Status = TrackOutcomeFailed
+					retried[i].Error = err.Error()
+				}
+			}
+		} else {
+			var mu sync.Mutex
+			s.AddMatchesContext(ctx, record.LibraryID, members, matches, onProgress, func(i int, status, errorMsg string) {
+				mu.Lock()
+				switch status {
+				case "added":
+					retried[i].Status = TrackOutcomeAdded
+				case "error":
+					retried[i].Status = TrackOutcomeFailed
+					retried[i].Error = errorMsg
+				}
+				mu.Unlock()
+				onTrackStatus(indices[i], status, errorMsg)
+			})
+			err = ctx.Err()
+		}
+	}
+
+	for i, t := range retried {
+		record.Tracks[indices[i]] = t
+	}
+	record.Recount()
+	if record.FailedTracks == 0 && err == nil {
+		record.Status = TransferStatusCompleted
+		record.ErrorMessage = ""
+	}
+	return err
+}
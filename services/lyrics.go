@@ -0,0 +1,292 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	lyricsCacheTTL        = 6 * time.Hour
+	lyricsCacheMaxEntries = 256
+)
+
+var (
+	lrcTimestampPattern = regexp.MustCompile(`^\[(\d+):(\d{1,2})(?:[.:](\d{1,3}))?\]`)
+	lrcTagPattern       = regexp.MustCompile(`^\[([a-zA-Z#]+):(.*)\]$`)
+)
+
+type LyricLine struct {
+	TimeMs int64  `json:"timeMs"`
+	Text   string `json:"text"`
+}
+
+type Lyrics struct {
+	Artist      string      `json:"artist"`
+	Title       string      `json:"title"`
+	Synced      bool        `json:"synced"`
+	Lines       []LyricLine `json:"lines"`
+	Plain       string      `json:"plain"`
+	TagOffsetMs int         `json:"tagOffsetMs"`
+	OffsetMs    int         `json:"offsetMs"`
+}
+
+func ParseLyrics(raw string) *Lyrics {
+	lines, offset, synced := ParseLRC(raw)
+	l := &Lyrics{Synced: synced, TagOffsetMs: offset}
+	if synced {
+		l.Lines = lines
+		texts := make([]string, 0, len(lines))
+		for _, line := range lines {
+			texts = append(texts, line.Text)
+		}
+		l.Plain = strings.Join(texts, "\n")
+		return l
+	}
+
+	l.Plain = strings.TrimSpace(strings.ReplaceAll(raw, "\r\n", "\n"))
+	l.Lines = []LyricLine{}
+	if l.Plain == "" {
+		return l
+	}
+	for _, text := range strings.Split(l.Plain, "\n") {
+		l.Lines = append(l.Lines, LyricLine{Text: strings.TrimSpace(text)})
+	}
+	return l
+}
+
+func ParseLRC(raw string) ([]LyricLine, int, bool) {
+	var lines []LyricLine
+	offset := 0
+
+	for _, rawLine := range strings.Split(strings.ReplaceAll(raw, "\r\n", "\n"), "\n") {
+		line := strings.TrimSpace(rawLine)
+		if line == "" {
+			continue
+		}
+
+		if m := lrcTagPattern.FindStringSubmatch(line); m != nil && !lrcTimestampPattern.MatchString(line) {
+			if strings.EqualFold(m[1], "offset") {
+				if v, err := strconv.Atoi(strings.TrimSpace(m[2])); err == nil {
+					offset = v
+				}
+			}
+			continue
+		}
+
+		var stamps []int64
+		for {
+			m := lrcTimestampPattern.FindStringSubmatch(line)
+			if m == nil {
+				break
+			}
+			stamps = append(stamps, lrcTimestampMs(m[1], m[2], m[3]))
+			line = strings.TrimSpace(line[len(m[0]):])
+		}
+		if len(stamps) == 0 {
+			continue
+		}
+		for _, ts := range stamps {
+			lines = append(lines, LyricLine{TimeMs: ts, Text: line})
+		}
+	}
+
+	if len(lines) == 0 {
+		return nil, offset, false
+	}
+
+	for i := range lines {
+		lines[i].TimeMs -= int64(offset)
+		if lines[i].TimeMs < 0 {
+			lines[i].TimeMs = 0
+		}
+	}
+	sort.SliceStable(lines, func(i, j int) bool {
+		return lines[i].TimeMs < lines[j].TimeMs
+	})
+	return lines, offset, true
+}
+
+func lrcTimestampMs(min, sec, frac string) int64 {
+	m, _ := strconv.ParseInt(min, 10, 64)
+	s, _ := strconv.ParseInt(sec, 10, 64)
+	ms := int64(0)
+	if frac != "" {
+		f, _ := strconv.ParseInt(frac, 10, 64)
+		switch len(frac) {
+		case 1:
+			ms = f * 100
+		case 2:
+			ms = f * 10
+		default:
+			ms = f
+		}
+	}
+	return m*60000 + s*1000 + ms
+}
+
+func (l *Lyrics) WithOffset(offsetMs int) *Lyrics {
+	out := *l
+	out.OffsetMs = offsetMs
+	out.Lines = make([]LyricLine, len(l.Lines))
+	copy(out.Lines, l.Lines)
+	if !l.Synced || offsetMs == 0 {
+		return &out
+	}
+	for i := range out.Lines {
+		out.Lines[i].TimeMs -= int64(offsetMs)
+		if out.Lines[i].TimeMs < 0 {
+			out.Lines[i].TimeMs = 0
+		}
+	}
+	return &out
+}
+
+func lyricsKey(artist, title string) string {
+	return strings.ToLower(strings.TrimSpace(artist)) + " - " + strings.ToLower(strings.TrimSpace(title))
+}
+
+type lyricsCacheEntry struct {
+	lyrics    *Lyrics
+	fetchedAt time.Time
+}
+
+type lyricsCache struct {
+	mu      sync.Mutex
+	entries map[string]lyricsCacheEntry
+}
+
+func newLyricsCache() *lyricsCache {
+	return &lyricsCache{entries: make(map[string]lyricsCacheEntry)}
+}
+
+func (c *lyricsCache) get(key string) (*Lyrics, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if time.Since(e.fetchedAt) > lyricsCacheTTL {
+		delete(c.entries, key)
+		return nil, false
+	}
+	return e.lyrics, true
+}
+
+func (c *lyricsCache) put(key string, l *Lyrics) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(c.entries) >= lyricsCacheMaxEntries {
+		oldestKey := ""
+		var oldest time.Time
+		for k, e := range c.entries {
+			if oldestKey == "" || e.fetchedAt.Before(oldest) {
+				oldestKey, oldest = k, e.fetchedAt
+			}
+		}
+		delete(c.entries, oldestKey)
+	}
+	c.entries[key] = lyricsCacheEntry{lyrics: l, fetchedAt: time.Now()}
+}
+
+func (s *DABService) GetLyrics(artist, title string) (*Lyrics, error) {
+	return s.GetLyricsContext(context.Background(), artist, title)
+}
+
+func (s *DABService) GetLyricsContext(ctx context.Context, artist, title string) (*Lyrics, error) {
+	key := lyricsKey(artist, title)
+	if l, ok := s.lyrics.get(key); ok {
+		return l, nil
+	}
+
+	url := fmt.Sprintf("%s/lyrics?artist=%s&title=%s",
+		s.BaseURL(),
+		urlQueryEscape(artist),
+		urlQueryEscape(title))
+
+	var result struct {
+		Lyrics string `json:"lyrics"`
+	}
+	if err := s.fetchJSONInto(ctx, url, &result); err != nil {
+		return nil, err
+	}
+
+	l := ParseLyrics(result.Lyrics)
+	l.Artist = artist
+	l.Title = title
+
+	s.lyrics.put(key, l)
+	return l, nil
+}
+
+type LyricsOffsetStore struct {
+	mu      sync.Mutex
+	path    string
+	offsets map[string]int
+}
+
+func NewLyricsOffsetStore() (*LyricsOffsetStore, error) {
+	dir, err := GetConfigDir()
+	if err != nil {
+		return nil, err
+	}
+	_ = os.MkdirAll(dir, 0755)
+
+	store := &LyricsOffsetStore{
+		path:    filepath.Join(dir, "lyrics_offsets.json"),
+		offsets: make(map[string]int),
+	}
+	data, err := os.ReadFile(store.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return store, nil
+		}
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &store.offsets); err != nil {
+		return nil, err
+	}
+	return store, nil
+}
+
+func (ls *LyricsOffsetStore) Get(artist, title string) int {
+	if ls == nil {
+		return 0
+	}
+	ls.mu.Lock()
+	defer ls.mu.Unlock()
+	return ls.offsets[lyricsKey(artist, title)]
+}
+
+func (ls *LyricsOffsetStore) Set(artist, title string, offsetMs int) error {
+	if ls == nil {
+		return fmt.Errorf("lyrics offset store unavailable")
+	}
+	ls.mu.Lock()
+	defer ls.mu.Unlock()
+
+	key := lyricsKey(artist, title)
+	if offsetMs == 0 {
+		delete(ls.offsets, key)
+	} else {
+		ls.offsets[key] = offsetMs
+	}
+
+	data, err := json.MarshalIndent(ls.offsets, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(ls.path), 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(ls.path, data, 0644)
+}
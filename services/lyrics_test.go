@@ -0,0 +1,39 @@
+package services
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseLRC(t *testing.T) {
+	tests := []struct {
+		name       string
+		raw        string
+		want       []LyricLine
+		wantOffset int
+		wantSynced bool
+	}{
+		{"plain text", "first line\nsecond line", nil, 0, false},
+		{"sorted by time", "[00:01.50]b\n[00:00.20]a", []LyricLine{{200, "a"}, {1500, "b"}}, 0, true},
+		{"fraction digits", "[01:02.5]a\n[01:02.05]b\n[01:02:123]c", []LyricLine{{62050, "b"}, {62123, "c"}, {62500, "a"}}, 0, true},
+		{"repeated stamps", "[00:01.00][00:03.00]chorus", []LyricLine{{1000, "chorus"}, {3000, "chorus"}}, 0, true},
+		{"metadata tags", "[ar:Artist]\n[ti:Title]\n[00:02.00]line", []LyricLine{{2000, "line"}}, 0, true},
+		{"offset", "[offset:500]\n[00:01.00]line", []LyricLine{{500, "line"}}, 500, true},
+		{"offset clamps at zero", "[offset:2000]\n[00:01.00]line", []LyricLine{{0, "line"}}, 2000, true},
+		{"crlf", "[00:01.00]a\r\n[00:02.00]b\r\n", []LyricLine{{1000, "a"}, {2000, "b"}}, 0, true},
+	}
+	for _, tt := range tests {
+		lines, offset, synced := ParseLRC(tt.raw)
+		if !reflect.DeepEqual(lines, tt.want) || offset != tt.wantOffset || synced != tt.wantSynced {
+			t.Errorf("%s: ParseLRC = %v, %d, %v; want %v, %d, %v", tt.name, lines, offset, synced, tt.want, tt.wantOffset, tt.wantSynced)
+		}
+	}
+}
+
+func TestParseLyricsPlain(t *testing.T) {
+	l := ParseLyrics("  first line\r\n second line  \n")
+	want := []LyricLine{{Text: "first line"}, {Text: "second line"}}
+	if l.Synced || l.Plain != "first line\n second line" || !reflect.DeepEqual(l.Lines, want) {
+		t.Errorf("ParseLyrics = %+v", l)
+	}
+}
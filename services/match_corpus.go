@@ -0,0 +1,78 @@
+package services
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+type MatchCase struct {
+	Name       string     `json:"name"`
+	Source     TrackInfo  `json:"source"`
+	Candidates []DABTrack `json:"candidates"`
+	Expected   string     `json:"expected"`
+}
+
+type MatchEvaluation struct {
+	Threshold      int      `json:"threshold"`
+	Cases          int      `json:"cases"`
+	TruePositives  int      `json:"truePositives"`
+	FalsePositives int      `json:"falsePositives"`
+	FalseNegatives int      `json:"falseNegatives"`
+	TrueNegatives  int      `json:"trueNegatives"`
+	Precision      float64  `json:"precision"`
+	Recall         float64  `json:"recall"`
+	Failures       []string `json:"failures"`
+}
+
+func LoadMatchCorpus(path string) ([]MatchCase, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var cases []MatchCase
+	if err := json.Unmarshal(data, &cases); err != nil {
+		return nil, fmt.Errorf("invalid match corpus %s: %w", path, err)
+	}
+	return cases, nil
+}
+
+func MatchedID(res MatchResult) string {
+	if !res.Matched || res.Track == nil {
+		return ""
+	}
+	return trackIDString(res.Track.ID)
+}
+
+func EvaluateMatcher(m Matcher, threshold int, cases []MatchCase) MatchEvaluation {
+	eval := MatchEvaluation{Threshold: threshold, Cases: len(cases), Failures: []string{}}
+	for _, c := range cases {
+		res := m.Match(c.Source, c.Candidates)
+		got := MatchedID(res)
+
+		switch {
+		case got != "" && got == c.Expected:
+			eval.TruePositives++
+		case got == "" && c.Expected == "":
+			eval.TrueNegatives++
+		case got == "":
+			eval.FalseNegatives++
+		case c.Expected == "":
+			eval.FalsePositives++
+		default:
+			eval.FalsePositives++
+			eval.FalseNegatives++
+		}
+		if got != c.Expected {
+			eval.Failures = append(eval.Failures, fmt.Sprintf("%s: expected %q, got %q (score %d) [%s]", c.Name, c.Expected, got, res.Score, res.Breakdown()))
+		}
+	}
+
+	if d := eval.TruePositives + eval.FalsePositives; d > 0 {
+		eval.Precision = float64(eval.TruePositives) / float64(d)
+	}
+	if d := eval.TruePositives + eval.FalseNegatives; d > 0 {
+		eval.Recall = float64(eval.TruePositives) / float64(d)
+	}
+	return eval
+}
@@ -0,0 +1,300 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	SessionStatusMatching  = "matching"
+	SessionStatusReady     = "ready"
+	SessionStatusCommitted = "committed"
+	SessionStatusCancelled = "cancelled"
+)
+
+type MatchSession struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	SourceURL   string `json:"sourceURL,omitempty"`
+	Status      string `json:"status"`
+	// CheckpointID and LibraryID are set by the first commit so later
+	// commits continue the same library instead of creating another one.
+	CheckpointID string         `json:"checkpointId,omitempty"`
+	LibraryID    string         `json:"libraryId,omitempty"`
+	CreatedAt    string         `json:"createdAt"`
+	UpdatedAt    string         `json:"updatedAt"`
+	Tracks       []TrackMatch   `json:"tracks"`
+	Stats        *TransferStats `json:"stats,omitempty"`
+}
+
+type MatchSessionSummary struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Status      string `json:"status"`
+	CreatedAt   string `json:"createdAt"`
+	UpdatedAt   string `json:"updatedAt"`
+	TotalTracks int    `json:"totalTracks"`
+	Matched     int    `json:"matched"`
+	NeedsReview int    `json:"needsReview"`
+}
+
+type MatchSelection struct {
+	Index int       `json:"index"`
+	Track *DABTrack `json:"track"`
+	Skip  bool      `json:"skip"`
+}
+
+func (ms *MatchSession) Summary() MatchSessionSummary {
+	sum := MatchSessionSummary{
+		ID:          ms.ID,
+		Name:        ms.Name,
+		Status:      ms.Status,
+		CreatedAt:   ms.CreatedAt,
+		UpdatedAt:   ms.UpdatedAt,
+		TotalTracks: len(ms.Tracks),
+	}
+	for _, t := range ms.Tracks {
+		switch {
+		case t.Track != nil:
+			sum.Matched++
+		case t.Status == MatchStatusReview:
+			sum.NeedsReview++
+		}
+	}
+	return sum
+}
+
+func (ms *MatchSession) Select(sel MatchSelection) error {
+	if sel.Index < 0 || sel.Index >= len(ms.Tracks) {
+		return fmt.Errorf("track index %d out of range", sel.Index)
+	}
+	t := &ms.Tracks[sel.Index]
+	if sel.Skip || sel.Track == nil {
+		t.Track = nil
+		t.Status = MatchStatusNotFound
+		t.Via = "skipped"
+		return nil
+	}
+	track := *sel.Track
+	t.Track = &track
+	t.Status = MatchStatusMatched
+	t.Via = "manual"
+	t.Score = 100
+	for _, c := range t.Candidates {
+		if trackIDString(c.Track.ID) == trackIDString(track.ID) {
+			t.Score = c.Score
+			t.Via = "review"
+			break
+		}
+	}
+	return nil
+}
+
+type MatchSessionStore struct {
+	mu  sync.Mutex
+	dir string
+}
+
+func NewMatchSessionStore() (*MatchSessionStore, error) {
+	dir, err := GetConfigDir()
+	if err != nil {
+		return nil, err
+	}
+	dir = filepath.Join(dir, "match_sessions")
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	return &MatchSessionStore{dir: dir}, nil
+}
+
+func (st *MatchSessionStore) path(id string) (string, error) {
+	if id == "" || strings.ContainsAny(id, `/\.`) {
+		return "", fmt.Errorf("invalid session id %q", id)
+	}
+	return filepath.Join(st.dir, id+".json"), nil
+}
+
+func (st *MatchSessionStore) Save(session *MatchSession) error {
+	if st == nil {
+		return fmt.Errorf("match session store unavailable")
+	}
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	if session.ID == "" {
+		session.ID = fmt.Sprintf("session_%d", time.Now().UnixMilli())
+	}
+	now := time.Now().Format(time.RFC3339)
+	if session.CreatedAt == "" {
+		session.CreatedAt = now
+	}
+	session.UpdatedAt = now
+
+	path, err := st.path(session.ID)
+	if err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(session, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0644)
+}
+
+func (st *MatchSessionStore) Load(id string) (*MatchSession, error) {
+	if st == nil {
+		return nil, fmt.Errorf("match session store unavailable")
+	}
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	path, err := st.path(id)
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("match session %s not found", id)
+		}
+		return nil, err
+	}
+	var session MatchSession
+	if err := json.Unmarshal(data, &session); err != nil {
+		return nil, err
+	}
+	return &session, nil
+}
+
+func (st *MatchSessionStore) List() ([]MatchSessionSummary, error) {
+	if st == nil {
+		return []MatchSessionSummary{}, nil
+	}
+	entries, err := os.ReadDir(st.dir)
+	if err != nil {
+		return nil, err
+	}
+
+	summaries := []MatchSessionSummary{}
+	for _, e := range entries {
+		if e.IsDir() || filepath.Ext(e.Name()) != ".json" {
+			continue
+		}
+		session, err := st.Load(strings.TrimSuffix(e.Name(), ".json"))
+		if err != nil {
+			continue
+		}
+		summaries = append(summaries, session.Summary())
+	}
+	sort.Slice(summaries, func(i, j int) bool {
+		return summaries[i].CreatedAt > summaries[j].CreatedAt
+	})
+	return summaries, nil
+}
+
+func (st *MatchSessionStore) Delete(id string) error {
+	if st == nil {
+		return fmt.Errorf("match session store unavailable")
+	}
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	path, err := st.path(id)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+func (s *DABService) StartMatchSessionContext(ctx context.Context, name, description string, tracks []TrackInfo, onProgress func(string), onTrackStatus func(int, string, string)) (*MatchSession, error) {
+	if s.AuthToken() == "" {
+		return nil, fmt.Errorf("not logged in to DAB")
+	}
+	session := &MatchSession{
+		Name:        name,
+		Description: description,
+		Status:      SessionStatusMatching,
+	}
+	session.Tracks = s.MatchTracksContext(ctx, tracks, onProgress, onTrackStatus)
+	session.Status = SessionStatusReady
+	if ctx.Err() != nil {
+		session.Status = SessionStatusCancelled
+	}
+	return session, nil
+}
+
+func (s *DABService) CommitMatchSessionContext(ctx context.Context, session *MatchSession, selections []MatchSelection, historyID string, onProgress func(string), onTrackStatus func(int, string, string)) (*TransferStats, error) {
+	for _, sel := range selections {
+		if err := session.Select(sel); err != nil {
+			return nil, err
+		}
+	}
+	for _, t := range session.Tracks {
+		if s.matchStore != nil && t.Track != nil && (t.Via == "manual" || t.Via == "review") {
+			for _, key := range MatchKeys(t.Source) {
+				if err := s.matchStore.Correct(key, *t.Track); err != nil {
+					onProgress(fmt.Sprintf("⚠ Failed to remember selection for '%s': %v", t.Source.Title, err))
+					break
+				}
+			}
+		}
+	}
+
+	pending := make([]TrackMatch, len(session.Tracks))
+	copy(pending, session.Tracks)
+	for i := range pending {
+		if pending[i].Status == MatchStatusCancelled && pending[i].Track == nil {
+			pending[i].Status = MatchStatusNotFound
+		}
+	}
+	origin := TransferOrigin{HistoryID: historyID, SourceURL: session.SourceURL}
+	cp := checkpointFromMatches(session.Name, session.Description, pending, origin)
+	s.continueSessionCheckpoint(session, cp)
+	stats, err := s.runCheckpointContext(ctx, cp, onProgress, onTrackStatus)
+	if cp.LibraryID != "" {
+		session.LibraryID = cp.LibraryID
+	}
+	return stats, err
+}
+
+// continueSessionCheckpoint ties every commit of a session to one checkpoint.
+// The ID is derived from the session so it survives a crash before the
+// session is saved again; tracks the previous attempt already added keep
+// their outcome.
+func (s *DABService) continueSessionCheckpoint(session *MatchSession, cp *ConversionCheckpoint) {
+	if session.CheckpointID == "" && session.ID != "" {
+		session.CheckpointID = "checkpoint_" + session.ID
+	}
+	cp.ID = session.CheckpointID
+	cp.LibraryID = session.LibraryID
+	if cp.ID == "" {
+		return
+	}
+	prev, err := s.checkpoints.Load(cp.ID)
+	if err != nil {
+		return
+	}
+	cp.CreatedAt = prev.CreatedAt
+	if prev.LibraryID != "" {
+		cp.LibraryID = prev.LibraryID
+	}
+	if cp.HistoryID == "" {
+		cp.HistoryID = prev.HistoryID
+	}
+	for i := range cp.Tracks {
+		if i < len(prev.Tracks) && prev.Tracks[i].AddStatus == AddStatusAdded {
+			cp.Tracks[i] = prev.Tracks[i]
+		}
+	}
+}
@@ -0,0 +1,213 @@
+package services
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+)
+
+type MatchEntry struct {
+	Key          string   `json:"key"`
+	SourceTitle  string   `json:"sourceTitle"`
+	SourceArtist string   `json:"sourceArtist"`
+	TrackID      string   `json:"trackId"`
+	Track        DABTrack `json:"track"`
+	Score        int      `json:"score"`
+	Manual       bool     `json:"manual"`
+	UpdatedAt    string   `json:"updatedAt"`
+}
+
+type MatchStore struct {
+	mu      sync.Mutex
+	path    string
+	entries map[string]MatchEntry
+	dirty   bool
+}
+
+func NewMatchStore() (*MatchStore, error) {
+	dir, err := GetConfigDir()
+	if err != nil {
+		return nil, err
+	}
+	_ = os.MkdirAll(dir, 0755)
+
+	ms := &MatchStore{
+		path:    filepath.Join(dir, "match_cache.json"),
+		entries: make(map[string]MatchEntry),
+	}
+	data, err := os.ReadFile(ms.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return ms, nil
+		}
+		return nil, err
+	}
+
+	var entries []MatchEntry
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return nil, err
+	}
+	for _, e := range entries {
+		ms.entries[e.Key] = e
+	}
+	return ms, nil
+}
+
+func MatchKeys(t TrackInfo) []string {
+	var keys []string
+	if t.SpotifyID != "" {
+		keys = append(keys, "spotify:"+t.SpotifyID)
+	} else if t.SourceID != "" {
+		keys = append(keys, "youtube:"+t.SourceID)
+	}
+	if isrc := normalizeISRC(t.ISRC); isrc != "" {
+		keys = append(keys, "isrc:"+isrc)
+	}
+	return keys
+}
+
+func (ms *MatchStore) Lookup(t TrackInfo) (MatchEntry, bool) {
+	if ms == nil {
+		return MatchEntry{}, false
+	}
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+	for _, key := range MatchKeys(t) {
+		if e, ok := ms.entries[key]; ok {
+			return e, true
+		}
+	}
+	return MatchEntry{}, false
+}
+
+func (ms *MatchStore) Put(t TrackInfo, track DABTrack, score int) {
+	if ms == nil {
+		return
+	}
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+	for _, key := range MatchKeys(t) {
+		if existing, ok := ms.entries[key]; ok && existing.Manual {
+			continue
+		}
+		ms.entries[key] = MatchEntry{
+			Key:          key,
+			SourceTitle:  t.Title,
+			SourceArtist: t.Artist,
+			TrackID:      trackIDString(track.ID),
+			Track:        track,
+			Score:        score,
+			UpdatedAt:    time.Now().Format(time.RFC3339),
+		}
+		ms.dirty = true
+	}
+}
+
+// Snapshot returns an in-memory copy that serves cached matches but never
+// writes back to disk.
+func (ms *MatchStore) Snapshot() *MatchStore {
+	if ms == nil {
+		return nil
+	}
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+	snap := &MatchStore{entries: make(map[string]MatchEntry, len(ms.entries))}
+	for k, e := range ms.entries {
+		snap.entries[k] = e
+	}
+	return snap
+}
+
+func (ms *MatchStore) Entries() []MatchEntry {
+	if ms == nil {
+		return []MatchEntry{}
+	}
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+	return ms.sortedLocked()
+}
+
+func (ms *MatchStore) Correct(key string, track DABTrack) error {
+	if ms == nil {
+		return fmt.Errorf("match store unavailable")
+	}
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	e, ok := ms.entries[key]
+	if !ok {
+		e = MatchEntry{Key: key}
+	}
+	e.Track = track
+	e.TrackID = trackIDString(track.ID)
+	e.Score = 100
+	e.Manual = true
+	e.UpdatedAt = time.Now().Format(time.RFC3339)
+	ms.entries[key] = e
+	return ms.saveLocked()
+}
+
+func (ms *MatchStore) Delete(key string) error {
+	if ms == nil {
+		return fmt.Errorf("match store unavailable")
+	}
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+	if _, ok := ms.entries[key]; !ok {
+		return fmt.Errorf("match cache entry %s not found", key)
+	}
+	delete(ms.entries, key)
+	return ms.saveLocked()
+}
+
+func (ms *MatchStore) Clear() error {
+	if ms == nil {
+		return fmt.Errorf("match store unavailable")
+	}
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+	ms.entries = make(map[string]MatchEntry)
+	return ms.saveLocked()
+}
+
+func (ms *MatchStore) Flush() error {
+	if ms == nil {
+		return nil
+	}
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+	if !ms.dirty || ms.path == "" {
+		return nil
+	}
+	return ms.saveLocked()
+}
+
+func (ms *MatchStore) sortedLocked() []MatchEntry {
+	entries := make([]MatchEntry, 0, len(ms.entries))
+	for _, e := range ms.entries {
+		entries = append(entries, e)
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Key < entries[j].Key
+	})
+	return entries
+}
+
+func (ms *MatchStore) saveLocked() error {
+	data, err := json.MarshalIndent(ms.sortedLocked(), "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(ms.path), 0755); err != nil {
+		return err
+	}
+	if err := os.WriteFile(ms.path, data, 0644); err != nil {
+		return err
+	}
+	ms.dirty = false
+	return nil
+}
@@ -0,0 +1,317 @@
+package services
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	defaultDurationTolerance = 3
+	defaultMatchThreshold    = 70
+	durationDecayScale       = 20.0
+	defaultMaxAlternatives   = 5
+)
+
+const (
+	SignalTitle    = "title"
+	SignalArtist   = "artist"
+	SignalAlbum    = "album"
+	SignalDuration = "duration"
+	SignalISRC     = "isrc"
+	SignalQuality  = "quality"
+)
+
+type Matcher interface {
+	Match(source TrackInfo, candidates []DABTrack) MatchResult
+}
+
+type SignalScore struct {
+	Name      string  `json:"name"`
+	Score     int     `json:"score"`
+	Weight    float64 `json:"weight"`
+	Available bool    `json:"available"`
+}
+
+type ScoredCandidate struct {
+	Track     DABTrack      `json:"track"`
+	Score     int           `json:"score"`
+	ExactISRC bool          `json:"exactISRC"`
+	Signals   []SignalScore `json:"signals"`
+}
+
+type MatchResult struct {
+	Track        *DABTrack         `json:"track"`
+	Score        int               `json:"score"`
+	Threshold    int               `json:"threshold"`
+	Matched      bool              `json:"matched"`
+	ExactISRC    bool              `json:"exactISRC"`
+	Signals      []SignalScore     `json:"signals"`
+	Candidates   int               `json:"candidates"`
+	Alternatives []ScoredCandidate `json:"alternatives"`
+}
+
+func (r MatchResult) Breakdown() string {
+	return signalBreakdown(r.ExactISRC, r.Signals)
+}
+
+func (c ScoredCandidate) Breakdown() string {
+	return signalBreakdown(c.ExactISRC, c.Signals)
+}
+
+func signalBreakdown(exactISRC bool, signals []SignalScore) string {
+	if exactISRC {
+		return "isrc exact"
+	}
+	parts := make([]string, 0, len(signals))
+	for _, sig := range signals {
+		if !sig.Available {
+			parts = append(parts, fmt.Sprintf("%s n/a", sig.Name))
+			continue
+		}
+		parts = append(parts, fmt.Sprintf("%s %d×%.2f", sig.Name, sig.Score, sig.Weight))
+	}
+	return strings.Join(parts, ", ")
+}
+
+type MatchWeights struct {
+	Title    float64 `json:"title"`
+	Artist   float64 `json:"artist"`
+	Album    float64 `json:"album"`
+	Duration float64 `json:"duration"`
+	ISRC     float64 `json:"isrc"`
+	Quality  float64 `json:"quality"`
+}
+
+func DefaultMatchWeights() MatchWeights {
+	return MatchWeights{
+		Title:    0.35,
+		Artist:   0.30,
+		Album:    0.10,
+		Duration: 0.25,
+		ISRC:     0.15,
+		Quality:  0.05,
+	}
+}
+
+type WeightedMatcher struct {
+	config          *Config
+	Weights         MatchWeights
+	MaxAlternatives int
+}
+
+func NewWeightedMatcher(cfg *Config) *WeightedMatcher {
+	return &WeightedMatcher{config: cfg, Weights: DefaultMatchWeights(), MaxAlternatives: defaultMaxAlternatives}
+}
+
+func (m *WeightedMatcher) threshold() int {
+	if m.config == nil || m.config.FuzzyMatchScale == 0 {
+		return defaultMatchThreshold
+	}
+	return m.config.FuzzyMatchScale
+}
+
+func (m *WeightedMatcher) tolerance() int {
+	if m.config == nil || m.config.DurationTolerance <= 0 {
+		return defaultDurationTolerance
+	}
+	return m.config.DurationTolerance
+}
+
+func (m *WeightedMatcher) Match(source TrackInfo, candidates []DABTrack) MatchResult {
+	result := MatchResult{Threshold: m.threshold(), Candidates: len(candidates), Alternatives: []ScoredCandidate{}}
+	if len(candidates) == 0 {
+		return result
+	}
+
+	isrc := normalizeISRC(source.ISRC)
+	scored := make([]ScoredCandidate, 0, len(candidates))
+	for _, c := range candidates {
+		if isrc != "" && normalizeISRC(c.ISRC) == isrc {
+			scored = append(scored, ScoredCandidate{
+				Track:     c,
+				Score:     100,
+				ExactISRC: true,
+				Signals:   []SignalScore{{Name: SignalISRC, Score: 100, Weight: m.Weights.ISRC, Available: true}},
+			})
+			continue
+		}
+		signals := m.signals(source, c)
+		scored = append(scored, ScoredCandidate{Track: c, Score: weightedScore(signals), Signals: signals})
+	}
+
+	sort.SliceStable(scored, func(i, j int) bool {
+		if scored[i].ExactISRC != scored[j].ExactISRC {
+			return scored[i].ExactISRC
+		}
+		return scored[i].Score > scored[j].Score
+	})
+
+	m.breakTies(source, scored, result.Threshold)
+
+	best := scored[0]
+	result.Track = &best.Track
+	result.Score = best.Score
+	result.ExactISRC = best.ExactISRC
+	result.Signals = best.Signals
+	result.Matched = best.ExactISRC || best.Score >= result.Threshold
+	if !result.Matched {
+		result.Track = nil
+	}
+
+	limit := m.MaxAlternatives
+	if limit <= 0 || limit > len(scored) {
+		limit = len(scored)
+	}
+	result.Alternatives = scored[:limit]
+	return result
+}
+
+func (m *WeightedMatcher) breakTies(source TrackInfo, scored []ScoredCandidate, threshold int) {
+	policy := VersionPolicyFromConfig(m.config)
+
+	n := 1
+	if scored[0].ExactISRC {
+		for n < len(scored) && scored[n].ExactISRC {
+			n++
+		}
+	} else {
+		floor := scored[0].Score - policy.TieMargin
+		if scored[0].Score >= threshold && floor < threshold {
+			floor = threshold
+		}
+		for n < len(scored) && scored[n].Score >= floor {
+			n++
+		}
+	}
+
+	sort.SliceStable(scored[:n], func(i, j int) bool {
+		return policy.less(source, scored[i].Track, scored[j].Track)
+	})
+}
+
+func (m *WeightedMatcher) signals(source TrackInfo, c DABTrack) []SignalScore {
+	w := m.Weights
+	signals := []SignalScore{
+		{Name: SignalTitle, Weight: w.Title},
+		{Name: SignalArtist, Weight: w.Artist},
+		{Name: SignalAlbum, Weight: w.Album},
+		{Name: SignalDuration, Weight: w.Duration},
+		{Name: SignalISRC, Weight: w.ISRC},
+		{Name: SignalQuality, Weight: w.Quality},
+	}
+
+	if source.Title != "" && c.Title != "" {
+		signals[0].Score = calculateSimilarity(source.Title, c.Title)
+		signals[0].Available = true
+	}
+	if source.Artist != "" && c.Artist != "" {
+		signals[1].Score = calculateSimilarity(source.Artist, c.Artist)
+		signals[1].Available = true
+	}
+	if source.AlbumTitle != "" && c.AlbumTitle != "" {
+		signals[2].Score = calculateSimilarity(source.AlbumTitle, c.AlbumTitle)
+		signals[2].Available = true
+	}
+	if sourceSec, candSec := float64(source.Duration)/1000, trackDurationSeconds(c.Duration); sourceSec > 0 && candSec > 0 {
+		signals[3].Score = durationScore(sourceSec, candSec, m.tolerance())
+		signals[3].Available = true
+	}
+	if normalizeISRC(source.ISRC) != "" && normalizeISRC(c.ISRC) != "" {
+		signals[4].Score = 0
+		signals[4].Available = true
+	}
+	if c.AudioQuality.MaxBitDepth > 0 || c.AudioQuality.IsHiRes {
+		signals[5].Score = 80
+		if isHiRes(c.AudioQuality) {
+			signals[5].Score = 100
+		}
+		signals[5].Available = true
+	}
+	return signals
+}
+
+func weightedScore(signals []SignalScore) int {
+	var total, weight float64
+	for _, sig := range signals {
+		if !sig.Available || sig.Weight <= 0 {
+			continue
+		}
+		total += float64(sig.Score) * sig.Weight
+		weight += sig.Weight
+	}
+	if weight == 0 {
+		return 0
+	}
+	return int(math.Round(total / weight))
+}
+
+func durationScore(sourceSec, candidateSec float64, tolerance int) int {
+	over := math.Abs(sourceSec-candidateSec) - float64(tolerance)
+	if over <= 0 {
+		return 100
+	}
+	return int(math.Round(100 * math.Exp(-over/durationDecayScale)))
+}
+
+func trackDurationSeconds(v interface{}) float64 {
+	switch d := v.(type) {
+	case float64:
+		return d
+	case int:
+		return float64(d)
+	case int64:
+		return float64(d)
+	case json.Number:
+		f, _ := d.Float64()
+		return f
+	case string:
+		f, _ := strconv.ParseFloat(strings.TrimSpace(d), 64)
+		return f
+	}
+	return 0
+}
+
+func normalizeISRC(isrc string) string {
+	isrc = strings.ToUpper(strings.TrimSpace(isrc))
+	isrc = strings.ReplaceAll(isrc, "-", "")
+	return strings.ReplaceAll(isrc, " ", "")
+}
+
+func calculateSimilarity(s1, s2 string) int {
+	s1 = normalizeString(s1)
+	s2 = normalizeString(s2)
+
+	tokens1 := strings.Fields(s1)
+	tokens2 := strings.Fields(s2)
+
+	if len(tokens1) == 0 || len(tokens2) == 0 {
+		return 0
+	}
+
+	matches := 0
+	t2Map := make(map[string]bool)
+	for _, t := range tokens2 {
+		t2Map[t] = true
+	}
+
+	for _, t1 := range tokens1 {
+		if t2Map[t1] {
+			matches++
+		}
+	}
+
+	minLen := len(tokens1)
+	if len(tokens2) < minLen {
+		minLen = len(tokens2)
+	}
+
+	if minLen == 0 {
+		return 0
+	}
+
+	return (matches * 100) / minLen
+}
@@ -0,0 +1,111 @@
+package services
+
+import "testing"
+
+func TestNormalizeString(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"Left Outside Alone", "left outside alone"},
+		{"Shadow of the Day (Official Music Video)", "shadow of the day"},
+		{"Oliver's Army", "olivers army"},
+		{"Stay (feat. Justin Bieber)", "stay justin bieber"},
+		{"Déjà Vu", "deja vu"},
+		{"Ｄｏｎ’ｔ Ｓｔｏｐ", "dont stop"},
+		{"Straße", "strasse"},
+		{"Группа крови", "gruppa krovi"},
+		{"ありがとう", "arigatou"},
+		{"キャンディ", "kyandi"},
+		{"ちょっと", "chotto"},
+		{"紅蓮華", "紅 蓮 華"},
+		{"Live and Let Die", "live and let die"},
+		{"Song 2 (Live)", "song 2"},
+		{"Hurt - Live at Wembley", "hurt at wembley"},
+		{"One More Time [Official Remix]", "one more time"},
+		{"사랑해", "saranghae"},
+		{"Original Mix", ""},
+	}
+	for _, tt := range tests {
+		if got := normalizeString(tt.in); got != tt.want {
+			t.Errorf("normalizeString(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestCalculateSimilarity(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"The Weeknd Blinding Lights", "The Weeknd Blinding Lights", 100},
+		{"Kino Gruppa Krovi", "Кино Группа крови", 100},
+		{"Adele Hello", "Lionel Richie Hello", 50},
+		{"", "anything", 0},
+	}
+	for _, tt := range tests {
+		if got := calculateSimilarity(tt.a, tt.b); got != tt.want {
+			t.Errorf("calculateSimilarity(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestDurationScore(t *testing.T) {
+	tests := []struct {
+		source, candidate float64
+		tolerance         int
+		want              int
+	}{
+		{200, 200, 3, 100},
+		{200, 203, 3, 100},
+		{200, 223, 3, 37},
+		{214, 637, 3, 0},
+	}
+	for _, tt := range tests {
+		if got := durationScore(tt.source, tt.candidate, tt.tolerance); got != tt.want {
+			t.Errorf("durationScore(%v, %v, %d) = %d, want %d", tt.source, tt.candidate, tt.tolerance, got, tt.want)
+		}
+	}
+}
+
+func TestNormalizeISRC(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"US-UM7-17-03861", "USUM71703861"},
+		{" usum71703861 ", "USUM71703861"},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		if got := normalizeISRC(tt.in); got != tt.want {
+			t.Errorf("normalizeISRC(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestMatcherCorpus(t *testing.T) {
+	cases, err := LoadMatchCorpus("testdata/match_corpus.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := NewWeightedMatcher(&Config{FuzzyMatchScale: 85, DurationTolerance: 3})
+	for _, c := range cases {
+		t.Run(c.Name, func(t *testing.T) {
+			res := m.Match(c.Source, c.Candidates)
+			if got := MatchedID(res); got != c.Expected {
+				t.Errorf("picked %q, want %q (score %d) [%s]", got, c.Expected, res.Score, res.Breakdown())
+			}
+		})
+	}
+}
+
+func TestEvaluateMatcher(t *testing.T) {
+	cases, err := LoadMatchCorpus("testdata/match_corpus.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	eval := EvaluateMatcher(NewWeightedMatcher(&Config{FuzzyMatchScale: 85, DurationTolerance: 3}), 85, cases)
+	if eval.Precision < 1 || eval.Recall < 1 {
+		t.Errorf("precision %.3f, recall %.3f at threshold 85: %v", eval.Precision, eval.Recall, eval.Failures)
+	}
+}
@@ -1,54 +1,90 @@
 package services
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/url"
 	"strings"
+	"time"
+)
+
+const (
+	musicBrainzBaseURL   = "https://musicbrainz.org/ws/2"
+	musicBrainzUserAgent = "0xDABmusic/2.1.1 ( https://github.com/0xArchit/0xDABmusic )"
+	musicBrainzTimeout   = 15 * time.Second
 
-	"github.com/michiwend/gomusicbrainz"
+	// MusicBrainz allows one request per second per client and blocks
+	// clients that exceed it.
+	musicBrainzRequestsPerSecond = 1.0
 )
 
 type MusicBrainzService struct {
-	client *gomusicbrainz.WS2Client
+	client  *http.Client
+	limiter *RateLimiter
+	baseURL string
+}
+
+type mbRecording struct {
+	Title        string `json:"title"`
+	Length       int    `json:"length"`
+	ArtistCredit []struct {
+		Name   string `json:"name"`
+		Artist struct {
+			Name string `json:"name"`
+		} `json:"artist"`
+	} `json:"artist-credit"`
+}
+
+type mbRecordingSearch struct {
+	Recordings []mbRecording `json:"recordings"`
 }
 
 func NewMusicBrainzService() *MusicBrainzService {
-	client, _ := gomusicbrainz.NewWS2Client(
-		"https://musicbrainz.org/ws/2",
-		"0xDABmusic",
-		"2.1.1",
-		"https://github.com/0xArchit/0xDABmusic",
-	)
 	return &MusicBrainzService{
-		client: client,
+		client:  &http.Client{Timeout: musicBrainzTimeout},
+		limiter: NewRateLimiter(musicBrainzRequestsPerSecond),
+		baseURL: musicBrainzBaseURL,
 	}
 }
 
 func (s *MusicBrainzService) ResolveTrackMetadata(title, artist string) (*TrackInfo, error) {
+	return s.ResolveTrackMetadataContext(context.Background(), title, artist)
+}
+
+func (s *MusicBrainzService) ResolveTrackMetadataContext(ctx context.Context, title, artist string) (*TrackInfo, error) {
 
 	cTitle := escapeLucene(cleanMBString(title))
 	cArtist := escapeLucene(cleanMBString(artist))
 
 	query := fmt.Sprintf("recording:(%s) AND artist:(%s)", cTitle, cArtist)
 
-	resp, err := s.client.SearchRecording(query, 1, 0)
+	recordings, err := s.searchRecordings(ctx, query)
 	if err != nil {
 		return nil, err
 	}
 
-	if len(resp.Recordings) == 0 {
+	if len(recordings) == 0 {
 
 		query = fmt.Sprintf("recording:(%s)", cTitle)
-		resp, err = s.client.SearchRecording(query, 1, 0)
-		if err != nil || len(resp.Recordings) == 0 {
+		recordings, err = s.searchRecordings(ctx, query)
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		if err != nil || len(recordings) == 0 {
 			return nil, nil
 		}
 	}
 
-	rec := resp.Recordings[0]
+	rec := recordings[0]
 
 	artistName := ""
-	if len(rec.ArtistCredit.NameCredits) > 0 {
-		artistName = rec.ArtistCredit.NameCredits[0].Artist.Name
+	if len(rec.ArtistCredit) > 0 {
+		artistName = rec.ArtistCredit[0].Artist.Name
+		if artistName == "" {
+			artistName = rec.ArtistCredit[0].Name
+		}
 	}
 
 	isrc := ""
@@ -61,6 +97,42 @@ func (s *MusicBrainzService) ResolveTrackMetadata(title, artist string) (*TrackI
 	}, nil
 }
 
+func (s *MusicBrainzService) searchRecordings(ctx context.Context, query string) ([]mbRecording, error) {
+	params := url.Values{}
+	params.Set("query", query)
+	params.Set("limit", "1")
+	params.Set("fmt", "json")
+
+	req, err := http.NewRequestWithContext(ctx, "GET", s.baseURL+"/recording?"+params.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", musicBrainzUserAgent)
+	req.Header.Set("Accept", "application/json")
+
+	if err := s.limiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusServiceUnavailable {
+		s.limiter.Throttle(parseRetryAfter(resp.Header.Get("Retry-After")))
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("musicbrainz search failed: %s", resp.Status)
+	}
+
+	var result mbRecordingSearch
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+	return result.Recordings, nil
+}
+
 func cleanMBString(s string) string {
 
 	if idx := strings.Index(s, "("); idx != -1 {
@@ -0,0 +1,297 @@
+package services
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+var strippedKeywords = [][]string{
+	{"feat"}, {"ft"}, {"featuring"}, {"original", "mix"},
+	{"official", "music", "video"}, {"official", "video"}, {"official", "audio"},
+	{"music", "video"}, {"lyric", "video"}, {"lyrics"}, {"full", "video"},
+	{"hd"}, {"hq"}, {"4k"}, {"mv"},
+}
+
+// qualifierKeywords are ordinary words in titles ("Live and Let Die"), so
+// they are only stripped inside brackets or after a " - " separator.
+var qualifierKeywords = [][]string{
+	{"remix"}, {"official"}, {"live"}, {"performance"},
+}
+
+var qualifierSeparators = []string{" - ", " – ", " — "}
+
+var letterFolds = map[rune]string{
+	'ß': "ss", 'æ': "ae", 'œ': "oe", 'ø': "o", 'đ': "d", 'ð': "d",
+	'ł': "l", 'þ': "th", 'ı': "i", 'ħ': "h", 'ŀ': "l",
+}
+
+var cyrillicRomanization = map[rune]string{
+	'а': "a", 'б': "b", 'в': "v", 'г': "g", 'д': "d", 'е': "e", 'ё': "e",
+	'ж': "zh", 'з': "z", 'и': "i", 'й': "y", 'к': "k", 'л': "l", 'м': "m",
+	'н': "n", 'о': "o", 'п': "p", 'р': "r", 'с': "s", 'т': "t", 'у': "u",
+	'ф': "f", 'х': "kh", 'ц': "ts", 'ч': "ch", 'ш': "sh", 'щ': "shch",
+	'ъ': "", 'ы': "y", 'ь': "", 'э': "e", 'ю': "yu", 'я': "ya",
+	'і': "i", 'ї': "yi", 'є': "ye", 'ґ': "g", 'ў': "u", 'ј': "j",
+	'љ': "lj", 'њ': "nj", 'ћ': "c", 'ђ': "dj", 'џ': "dz", 'ѓ': "gj", 'ќ': "kj", 'ѕ': "dz",
+}
+
+var kanaRomanization = map[rune]string{
+	'あ': "a", 'い': "i", 'う': "u", 'え': "e", 'お': "o",
+	'か': "ka", 'き': "ki", 'く': "ku", 'け': "ke", 'こ': "ko",
+	'が': "ga", 'ぎ': "gi", 'ぐ': "gu", 'げ': "ge", 'ご': "go",
+	'さ': "sa", 'し': "shi", 'す': "su", 'せ': "se", 'そ': "so",
+	'ざ': "za", 'じ': "ji", 'ず': "zu", 'ぜ': "ze", 'ぞ': "zo",
+	'た': "ta", 'ち': "chi", 'つ': "tsu", 'て': "te", 'と': "to",
+	'だ': "da", 'ぢ': "ji", 'づ': "zu", 'で': "de", 'ど': "do",
+	'な': "na", 'に': "ni", 'ぬ': "nu", 'ね': "ne", 'の': "no",
+	'は': "ha", 'ひ': "hi", 'ふ': "fu", 'へ': "he", 'ほ': "ho",
+	'ば': "ba", 'び': "bi", 'ぶ': "bu", 'べ': "be", 'ぼ': "bo",
+	'ぱ': "pa", 'ぴ': "pi", 'ぷ': "pu", 'ぺ': "pe", 'ぽ': "po",
+	'ま': "ma", 'み': "mi", 'む': "mu", 'め': "me", 'も': "mo",
+	'や': "ya", 'ゆ': "yu", 'よ': "yo",
+	'ら': "ra", 'り': "ri", 'る': "ru", 'れ': "re", 'ろ': "ro",
+	'わ': "wa", 'ゐ': "i", 'ゑ': "e", 'を': "o", 'ん': "n", 'ゔ': "vu",
+	'ぁ': "a", 'ぃ': "i", 'ぅ': "u", 'ぇ': "e", 'ぉ': "o", 'ゎ': "wa",
+}
+
+var kanaYoon = map[rune]string{'ゃ': "ya", 'ゅ': "yu", 'ょ': "yo"}
+
+var kanaSmallVowels = map[rune]string{'ぁ': "a", 'ぃ': "i", 'ぅ': "u", 'ぇ': "e", 'ぉ': "o"}
+
+var (
+	hangulInitials = []string{"g", "kk", "n", "d", "tt", "r", "m", "b", "pp", "s", "ss", "", "j", "jj", "ch", "k", "t", "p", "h"}
+	hangulVowels   = []string{"a", "ae", "ya", "yae", "eo", "e", "yeo", "ye", "o", "wa", "wae", "oe", "yo", "u", "wo", "we", "wi", "yu", "eu", "ui", "i"}
+	hangulFinals   = []string{"", "k", "k", "k", "n", "n", "n", "t", "l", "k", "m", "l", "l", "l", "p", "l", "m", "p", "p", "t", "t", "ng", "t", "t", "k", "t", "p", "t"}
+)
+
+func normalizeString(s string) string {
+	var out []string
+	for _, seg := range splitQualifiers(s) {
+		tokens := removeKeywords(tokenize(seg.text), strippedKeywords)
+		if seg.qualifier {
+			tokens = removeKeywords(tokens, qualifierKeywords)
+		}
+		out = append(out, tokens...)
+	}
+	return strings.Join(out, " ")
+}
+
+type titleSegment struct {
+	text      string
+	qualifier bool
+}
+
+func splitQualifiers(s string) []titleSegment {
+	s = norm.NFKC.String(s)
+
+	var segments []titleSegment
+	var current strings.Builder
+	depth := 0
+	dashed := false
+	flush := func() {
+		if current.Len() > 0 {
+			segments = append(segments, titleSegment{text: current.String(), qualifier: dashed || depth > 0})
+			current.Reset()
+		}
+	}
+	for i, r := range s {
+		switch r {
+		case '(', '[', '{', '【':
+			flush()
+			depth++
+			continue
+		case ')', ']', '}', '】':
+			if depth > 0 {
+				flush()
+				depth--
+				continue
+			}
+		}
+		if depth == 0 && !dashed && r == ' ' {
+			for _, sep := range qualifierSeparators {
+				if strings.HasPrefix(s[i:], sep) {
+					flush()
+					dashed = true
+					break
+				}
+			}
+		}
+		current.WriteRune(r)
+	}
+	flush()
+	return segments
+}
+
+func tokenize(s string) []string {
+	s = foldText(s)
+
+	var tokens []string
+	var current strings.Builder
+	flush := func() {
+		if current.Len() > 0 {
+			tokens = append(tokens, current.String())
+			current.Reset()
+		}
+	}
+	for _, r := range s {
+		switch {
+		case isIdeograph(r):
+			flush()
+			tokens = append(tokens, string(r))
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			current.WriteRune(r)
+		default:
+			flush()
+		}
+	}
+	flush()
+	return tokens
+}
+
+func foldText(s string) string {
+	s = norm.NFKC.String(s)
+	s = strings.ToLower(s)
+	s = stripApostrophes(s)
+	s = transliterate(s)
+
+	var b strings.Builder
+	for _, r := range norm.NFKD.String(s) {
+		if unicode.Is(unicode.Mn, r) {
+			continue
+		}
+		if f, ok := letterFolds[r]; ok {
+			b.WriteString(f)
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return norm.NFC.String(b.String())
+}
+
+func stripApostrophes(s string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case '\'', '’', '‘', 'ʼ', '`', '´', 'ʻ', '′':
+			return -1
+		}
+		return r
+	}, s)
+}
+
+func transliterate(s string) string {
+	runes := []rune(s)
+	var b strings.Builder
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		if r >= 'ァ' && r <= 'ヶ' {
+			r -= 0x60
+		}
+
+		if out, ok := cyrillicRomanization[r]; ok {
+			b.WriteString(out)
+			continue
+		}
+		if r >= 0xAC00 && r <= 0xD7A3 {
+			b.WriteString(romanizeHangul(r))
+			continue
+		}
+
+		switch {
+		case r == 'っ':
+			if i+1 < len(runes) {
+				next := runes[i+1]
+				if next >= 'ァ' && next <= 'ヶ' {
+					next -= 0x60
+				}
+				if syl, ok := kanaRomanization[next]; ok && syl != "n" && !strings.ContainsRune("aiueo", rune(syl[0])) {
+					b.WriteByte(syl[0])
+				}
+			}
+			continue
+		case r == 'ー':
+			continue
+		}
+
+		if out, ok := kanaRomanization[r]; ok {
+			if i+1 < len(runes) {
+				next := runes[i+1]
+				if next >= 'ァ' && next <= 'ヶ' {
+					next -= 0x60
+				}
+				if y, ok := kanaYoon[next]; ok && strings.HasSuffix(out, "i") && len(out) > 1 {
+					stem := strings.TrimSuffix(out, "i")
+					if stem == "sh" || stem == "ch" || stem == "j" {
+						y = y[1:]
+					}
+					b.WriteString(stem + y)
+					i++
+					continue
+				}
+				if v, ok := kanaSmallVowels[next]; ok {
+					stem := out[:len(out)-1]
+					if out == "u" {
+						stem = "w"
+					}
+					b.WriteString(stem + v)
+					i++
+					continue
+				}
+			}
+			b.WriteString(out)
+			continue
+		}
+		if y, ok := kanaYoon[r]; ok {
+			b.WriteString(y)
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// romanizeHangul applies Revised Romanization per syllable, without the
+// sound-change rules between syllables.
+func romanizeHangul(r rune) string {
+	idx := int(r - 0xAC00)
+	return hangulInitials[idx/(21*28)] + hangulVowels[idx%(21*28)/28] + hangulFinals[idx%28]
+}
+
+// isIdeograph reports Han characters, which have no reading-independent
+// romanization; they are matched one character per token instead.
+func isIdeograph(r rune) bool {
+	return unicode.Is(unicode.Han, r)
+}
+
+func removeKeywords(tokens []string, keywords [][]string) []string {
+	out := make([]string, 0, len(tokens))
+	for i := 0; i < len(tokens); {
+		if n := keywordAt(tokens, i, keywords); n > 0 {
+			i += n
+			continue
+		}
+		out = append(out, tokens[i])
+		i++
+	}
+	return out
+}
+
+func keywordAt(tokens []string, i int, keywords [][]string) int {
+	for _, phrase := range keywords {
+		if i+len(phrase) > len(tokens) {
+			continue
+		}
+		match := true
+		for j, w := range phrase {
+			if tokens[i+j] != w {
+				match = false
+				break
+			}
+		}
+		if match {
+			return len(phrase)
+		}
+	}
+	return 0
+}
@@ -0,0 +1,138 @@
+package services
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+const (
+	defaultDABRequestsPerSecond = 3.0
+	rateLimiterMinRate          = 0.2
+	rateLimiterRecoverAfter     = 10 * time.Second
+	rateLimiterRecoverStep      = 0.1
+)
+
+type RateLimiter struct {
+	mu          sync.Mutex
+	target      float64
+	rate        float64
+	burst       float64
+	tokens      float64
+	last        time.Time
+	pausedUntil time.Time
+	lastChange  time.Time
+}
+
+func NewRateLimiter(requestsPerSecond float64) *RateLimiter {
+	if requestsPerSecond <= 0 {
+		requestsPerSecond = defaultDABRequestsPerSecond
+	}
+	now := time.Now()
+	l := &RateLimiter{
+		target:     requestsPerSecond,
+		rate:       requestsPerSecond,
+		last:       now,
+		lastChange: now,
+	}
+	l.burst = burstFor(requestsPerSecond)
+	l.tokens = l.burst
+	return l
+}
+
+func burstFor(rate float64) float64 {
+	if rate < 1 {
+		return 1
+	}
+	return rate
+}
+
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	for {
+		l.mu.Lock()
+		now := time.Now()
+		l.refill(now)
+
+		var wait time.Duration
+		if now.Before(l.pausedUntil) {
+			wait = l.pausedUntil.Sub(now)
+		} else if l.tokens >= 1 {
+			l.tokens--
+			l.mu.Unlock()
+			return nil
+		} else {
+			wait = time.Duration((1 - l.tokens) / l.rate * float64(time.Second))
+		}
+		l.mu.Unlock()
+
+		if err := sleepContext(ctx, wait); err != nil {
+			return err
+		}
+	}
+}
+
+func (l *RateLimiter) refill(now time.Time) {
+	if l.rate < l.target && now.Sub(l.lastChange) >= rateLimiterRecoverAfter {
+		l.rate += l.target * rateLimiterRecoverStep
+		if l.rate > l.target {
+			l.rate = l.target
+		}
+		l.burst = burstFor(l.rate)
+		l.lastChange = now
+	}
+
+	elapsed := now.Sub(l.last).Seconds()
+	if elapsed > 0 {
+		l.tokens += elapsed * l.rate
+		if l.tokens > l.burst {
+			l.tokens = l.burst
+		}
+	}
+	l.last = now
+}
+
+func (l *RateLimiter) Throttle(retryAfter time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	l.refill(now)
+
+	l.rate /= 2
+	if l.rate < rateLimiterMinRate {
+		l.rate = rateLimiterMinRate
+	}
+	l.burst = burstFor(l.rate)
+	if l.tokens > 0 {
+		l.tokens = 0
+	}
+	l.lastChange = now
+
+	if retryAfter > 0 {
+		if until := now.Add(retryAfter); until.After(l.pausedUntil) {
+			l.pausedUntil = until
+		}
+	}
+}
+
+func (l *RateLimiter) SetRate(requestsPerSecond float64) {
+	if requestsPerSecond <= 0 {
+		requestsPerSecond = defaultDABRequestsPerSecond
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.refill(time.Now())
+	l.target = requestsPerSecond
+	l.rate = requestsPerSecond
+	l.burst = burstFor(requestsPerSecond)
+	if l.tokens > l.burst {
+		l.tokens = l.burst
+	}
+}
+
+func (l *RateLimiter) Rate() float64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.rate
+}
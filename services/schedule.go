@@ -0,0 +1,215 @@
+package services
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const minWatchInterval = 5 * time.Minute
+
+type Schedule interface {
+	Next(after time.Time) time.Time
+}
+
+type intervalSchedule time.Duration
+
+func (d intervalSchedule) Next(after time.Time) time.Time {
+	return after.Add(time.Duration(d))
+}
+
+type cronSchedule struct {
+	minute, hour, dom, month, dow uint64
+	domAny, dowAny                bool
+}
+
+var cronDescriptors = map[string]string{
+	"@hourly":   "0 * * * *",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@weekly":   "0 0 * * 0",
+	"@monthly":  "0 0 1 * *",
+}
+
+func ParseSchedule(spec string) (Schedule, error) {
+	spec = strings.TrimSpace(spec)
+	if spec == "" {
+		return nil, fmt.Errorf("schedule is empty")
+	}
+	if expr, ok := cronDescriptors[strings.ToLower(spec)]; ok {
+		spec = expr
+	}
+	if strings.HasPrefix(spec, "@every ") || !strings.Contains(spec, " ") {
+		d, err := time.ParseDuration(strings.TrimSpace(strings.TrimPrefix(spec, "@every ")))
+		if err != nil {
+			return nil, fmt.Errorf("invalid schedule %q: %w", spec, err)
+		}
+		if d < minWatchInterval {
+			return nil, fmt.Errorf("schedule interval must be at least %s", minWatchInterval)
+		}
+		return intervalSchedule(d), nil
+	}
+	c, err := parseCron(spec)
+	if err != nil {
+		return nil, err
+	}
+	if c.minGap() < minWatchInterval {
+		return nil, fmt.Errorf("schedule must not run more often than every %s", minWatchInterval)
+	}
+	return c, nil
+}
+
+func parseCron(spec string) (*cronSchedule, error) {
+	fields := strings.Fields(spec)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("invalid cron expression %q: expected 5 fields", spec)
+	}
+	var c cronSchedule
+	var err error
+	if c.minute, err = parseCronField(fields[0], 0, 59); err != nil {
+		return nil, err
+	}
+	if c.hour, err = parseCronField(fields[1], 0, 23); err != nil {
+		return nil, err
+	}
+	if c.dom, err = parseCronField(fields[2], 1, 31); err != nil {
+		return nil, err
+	}
+	if c.month, err = parseCronField(fields[3], 1, 12); err != nil {
+		return nil, err
+	}
+	if c.dow, err = parseCronField(fields[4], 0, 7); err != nil {
+		return nil, err
+	}
+	if c.dow&(1<<7) != 0 {
+		c.dow |= 1
+	}
+	c.domAny = fields[2] == "*"
+	c.dowAny = fields[4] == "*"
+	return &c, nil
+}
+
+func parseCronField(field string, min, max int) (uint64, error) {
+	var bits uint64
+	for _, part := range strings.Split(field, ",") {
+		rng, step := part, 1
+		if i := strings.Index(part, "/"); i >= 0 {
+			n, err := strconv.Atoi(part[i+1:])
+			if err != nil || n <= 0 {
+				return 0, fmt.Errorf("invalid cron step in %q", field)
+			}
+			rng, step = part[:i], n
+		}
+
+		lo, hi := min, max
+		switch {
+		case rng == "*":
+		case strings.Contains(rng, "-"):
+			bounds := strings.SplitN(rng, "-", 2)
+			a, errA := strconv.Atoi(bounds[0])
+			b, errB := strconv.Atoi(bounds[1])
+			if errA != nil || errB != nil {
+				return 0, fmt.Errorf("invalid cron range in %q", field)
+			}
+			lo, hi = a, b
+		default:
+			n, err := strconv.Atoi(rng)
+			if err != nil {
+				return 0, fmt.Errorf("invalid cron value in %q", field)
+			}
+			lo = n
+			if step == 1 {
+				hi = n
+			}
+		}
+		if lo < min || hi > max || lo > hi {
+			return 0, fmt.Errorf("cron value out of range in %q", field)
+		}
+		for v := lo; v <= hi; v += step {
+			bits |= 1 << uint(v)
+		}
+	}
+	return bits, nil
+}
+
+func (c *cronSchedule) dayMatches(t time.Time) bool {
+	dom := c.dom&(1<<uint(t.Day())) != 0
+	dow := c.dow&(1<<uint(t.Weekday())) != 0
+	if c.domAny || c.dowAny {
+		return dom && dow
+	}
+	return dom || dow
+}
+
+func (c *cronSchedule) Next(after time.Time) time.Time {
+	t := after.Truncate(time.Minute).Add(time.Minute)
+	limit := t.AddDate(5, 0, 0)
+	for t.Before(limit) {
+		y, m, d := t.Date()
+		switch {
+		case c.month&(1<<uint(m)) == 0:
+			t = time.Date(y, m+1, 1, 0, 0, 0, 0, t.Location())
+		case !c.dayMatches(t):
+			t = time.Date(y, m, d+1, 0, 0, 0, 0, t.Location())
+		case c.hour&(1<<uint(t.Hour())) == 0:
+			t = time.Date(y, m, d, t.Hour()+1, 0, 0, 0, t.Location())
+		case c.minute&(1<<uint(t.Minute())) == 0:
+			t = t.Add(time.Minute)
+		default:
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+// minGap returns the shortest time between two consecutive runs. Runs less
+// than an hour apart are either in the same hour or straddle the end of one
+// matching hour and the start of the next, so only those gaps need checking.
+func (c *cronSchedule) minGap() time.Duration {
+	var minutes []int
+	for m := 0; m < 60; m++ {
+		if c.minute&(1<<uint(m)) != 0 {
+			minutes = append(minutes, m)
+		}
+	}
+	if len(minutes) == 0 {
+		return 24 * time.Hour
+	}
+
+	gap := 60
+	for i := 1; i < len(minutes); i++ {
+		if d := minutes[i] - minutes[i-1]; d < gap {
+			gap = d
+		}
+	}
+	wrap := 60 - minutes[len(minutes)-1] + minutes[0]
+	if wrap < gap && c.hasConsecutiveHours() {
+		gap = wrap
+	}
+	return time.Duration(gap) * time.Minute
+}
+
+func (c *cronSchedule) hasConsecutiveHours() bool {
+	for h := 0; h < 23; h++ {
+		if c.hour&(1<<uint(h)) != 0 && c.hour&(1<<uint(h+1)) != 0 {
+			return true
+		}
+	}
+	if c.hour&(1<<23) == 0 || c.hour&1 == 0 {
+		return false
+	}
+	// 23:xx and 00:xx are only consecutive when two consecutive days match;
+	// four years cover every weekday and month-length combination.
+	day := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+	prev := false
+	for i := 0; i < 4*366; i++ {
+		ok := c.month&(1<<uint(day.Month())) != 0 && c.dayMatches(day)
+		if ok && prev {
+			return true
+		}
+		prev = ok
+		day = day.AddDate(0, 0, 1)
+	}
+	return false
+}
@@ -0,0 +1,69 @@
+package services
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseSchedule(t *testing.T) {
+	tests := []struct {
+		spec    string
+		wantErr bool
+	}{
+		{"", true},
+		{"30m", false},
+		{"@every 1h", false},
+		{"1m", true},
+		{"@hourly", false},
+		{"0 9 * * 1-5", false},
+		{"*/5 * * * *", false},
+		{"* * * * *", true},
+		{"*/2 * * * *", true},
+		{"58,59 * * * *", true},
+		{"0,58 * * * *", true},
+		{"0,58 12 * * *", false},
+		{"0,58 0,23 * * *", true},
+		{"0,58 0,23 31 * *", false},
+		{"60 * * * *", true},
+		{"*/0 * * * *", true},
+		{"0 9 * *", true},
+	}
+	for _, tt := range tests {
+		_, err := ParseSchedule(tt.spec)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseSchedule(%q) error = %v, wantErr %v", tt.spec, err, tt.wantErr)
+		}
+	}
+}
+
+func TestCronNext(t *testing.T) {
+	at := func(s string) time.Time {
+		v, err := time.Parse("2006-01-02 15:04", s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return v
+	}
+	tests := []struct {
+		spec  string
+		after string
+		want  string
+	}{
+		{"*/15 * * * *", "2026-10-17 10:07", "2026-10-17 10:15"},
+		{"*/15 * * * *", "2026-10-17 10:15", "2026-10-17 10:30"},
+		{"0 9 * * 1-5", "2026-10-16 10:00", "2026-10-19 09:00"},
+		{"0 0 1 * *", "2026-10-17 00:00", "2026-11-01 00:00"},
+		{"0 12 * * 7", "2026-10-17 13:00", "2026-10-18 12:00"},
+		{"30 4 1,15 * 5", "2026-10-17 00:00", "2026-10-23 04:30"},
+		{"@daily", "2026-12-31 23:59", "2027-01-01 00:00"},
+	}
+	for _, tt := range tests {
+		s, err := ParseSchedule(tt.spec)
+		if err != nil {
+			t.Fatalf("ParseSchedule(%q): %v", tt.spec, err)
+		}
+		if got := s.Next(at(tt.after)); !got.Equal(at(tt.want)) {
+			t.Errorf("%q.Next(%s) = %s, want %s", tt.spec, tt.after, got.Format("2006-01-02 15:04"), tt.want)
+		}
+	}
+}
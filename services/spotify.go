@@ -183,6 +183,12 @@ type TrackInfo struct {
 	AlbumCover  string `json:"album_cover"`
 	ReleaseDate string `json:"release_date"`
 	Genre       string `json:"genre"`
+	Explicit    bool   `json:"explicit"`
+	DiscNumber  int    `json:"disc_number,omitempty"`
+	TrackNumber int    `json:"track_number,omitempty"`
+	AlbumArtist string `json:"album_artist,omitempty"`
+	AlbumUPC    string `json:"album_upc,omitempty"`
+	AlbumTracks int    `json:"album_tracks,omitempty"`
 }
 
 type PlaylistInfo struct {
@@ -221,6 +227,7 @@ func (s *SpotifyService) GetPlaylistTracks(url string) (*PlaylistInfo, error) {
 			Duration:  int(track.Duration),
 			SpotifyID: string(track.ID),
 			SourceID:  string(track.ID),
+			Explicit:  track.Explicit,
 		}
 
 		return &PlaylistInfo{
@@ -240,6 +247,15 @@ func (s *SpotifyService) GetPlaylistTracks(url string) (*PlaylistInfo, error) {
 			return nil, err
 		}
 
+		albumArtist := ""
+		if len(album.Artists) > 0 {
+			albumArtist = album.Artists[0].Name
+		}
+		albumCover := ""
+		if len(album.Images) > 0 {
+			albumCover = album.Images[0].URL
+		}
+
 		var tracks []TrackInfo
 		offset := 0
 		limit := 50
@@ -261,12 +277,21 @@ func (s *SpotifyService) GetPlaylistTracks(url string) (*PlaylistInfo, error) {
 				}
 
 				tracks = append(tracks, TrackInfo{
-					Title:     fullTrack.Name,
-					Artist:    strings.Join(artists, ", "),
-					ISRC:      fullTrack.ExternalIDs["isrc"],
-					Duration:  int(fullTrack.Duration),
-					SpotifyID: string(fullTrack.ID),
-					SourceID:  string(fullTrack.ID),
+					Title:       fullTrack.Name,
+					Artist:      strings.Join(artists, ", "),
+					ISRC:        fullTrack.ExternalIDs["isrc"],
+					Duration:    int(fullTrack.Duration),
+					SpotifyID:   string(fullTrack.ID),
+					SourceID:    string(fullTrack.ID),
+					Explicit:    fullTrack.Explicit,
+					AlbumTitle:  album.Name,
+					AlbumCover:  albumCover,
+					ReleaseDate: album.ReleaseDate,
+					DiscNumber:  int(fullTrack.DiscNumber),
+					TrackNumber: int(fullTrack.TrackNumber),
+					AlbumArtist: albumArtist,
+					AlbumUPC:    album.ExternalIDs["upc"],
+					AlbumTracks: int(album.Tracks.Total),
 				})
 			}
 
@@ -278,7 +303,7 @@ func (s *SpotifyService) GetPlaylistTracks(url string) (*PlaylistInfo, error) {
 
 		return &PlaylistInfo{
 			Name:        album.Name,
-			Description: fmt.Sprintf("Album by %s", album.Artists[0].Name),
+			Description: fmt.Sprintf("Album by %s", albumArtist),
 			Tracks:      tracks,
 		}, nil
 	}
@@ -320,6 +345,7 @@ func (s *SpotifyService) GetPlaylistTracks(url string) (*PlaylistInfo, error) {
 				Duration:  int(track.Duration),
 				SpotifyID: track.ID.String(),
 				SourceID:  track.ExternalURLs["spotify"],
+				Explicit:  track.Explicit,
 			})
 		}
 
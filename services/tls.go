@@ -0,0 +1,116 @@
+package services
+
+import (
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+)
+
+const (
+	TLSModeVerify   = "verify"
+	TLSModeInsecure = "insecure"
+)
+
+func buildTLSConfig(cfg *Config) (*tls.Config, error) {
+	tlsCfg := &tls.Config{MinVersion: tls.VersionTLS12}
+	if cfg == nil {
+		return tlsCfg, nil
+	}
+
+	switch strings.ToLower(strings.TrimSpace(cfg.DABTLSMode)) {
+	case "", TLSModeVerify:
+	case TLSModeInsecure:
+		tlsCfg.InsecureSkipVerify = true
+	default:
+		return nil, fmt.Errorf("unknown TLS mode %q", cfg.DABTLSMode)
+	}
+
+	if path := strings.TrimSpace(cfg.DABCACertPath); path != "" {
+		pem, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA bundle: %w", err)
+		}
+		pool, err := x509.SystemCertPool()
+		if err != nil || pool == nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in CA bundle %s", path)
+		}
+		tlsCfg.RootCAs = pool
+	}
+
+	pins, err := parseSPKIPins(cfg.DABPinnedSPKI)
+	if err != nil {
+		return nil, err
+	}
+	if len(pins) > 0 {
+		tlsCfg.VerifyConnection = func(cs tls.ConnectionState) error {
+			for _, cert := range cs.PeerCertificates {
+				if _, ok := pins[spkiHash(cert)]; ok {
+					return nil
+				}
+			}
+			return fmt.Errorf("no certificate presented by %s matches a pinned SPKI hash", cs.ServerName)
+		}
+	}
+
+	return tlsCfg, nil
+}
+
+func parseSPKIPins(raw []string) (map[string]struct{}, error) {
+	pins := make(map[string]struct{}, len(raw))
+	for _, p := range raw {
+		p = strings.TrimSpace(p)
+		if p == "" {
+			continue
+		}
+		p = strings.TrimPrefix(p, "sha256/")
+		decoded, err := base64.StdEncoding.DecodeString(p)
+		if err != nil || len(decoded) != sha256.Size {
+			return nil, fmt.Errorf("invalid SPKI pin %q: expected base64 encoded SHA-256", p)
+		}
+		pins[p] = struct{}{}
+	}
+	return pins, nil
+}
+
+func spkiHash(cert *x509.Certificate) string {
+	sum := sha256.Sum256(cert.RawSubjectPublicKeyInfo)
+	return base64.StdEncoding.EncodeToString(sum[:])
+}
+
+type mirrorTransport struct {
+	mirror   http.RoundTripper
+	fallback http.RoundTripper
+	host     string
+}
+
+func (t *mirrorTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if strings.EqualFold(req.URL.Hostname(), t.host) {
+		return t.mirror.RoundTrip(req)
+	}
+	return t.fallback.RoundTrip(req)
+}
+
+func newHTTPClient(cfg *Config, tlsHost string) (*http.Client, error) {
+	tlsCfg, err := buildTLSConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	tr := http.DefaultTransport.(*http.Transport).Clone()
+	tr.TLSClientConfig = tlsCfg
+	if tlsHost == "" {
+		return &http.Client{Transport: tr}, nil
+	}
+	return &http.Client{Transport: &mirrorTransport{
+		mirror:   tr,
+		fallback: http.DefaultTransport.(*http.Transport).Clone(),
+		host:     tlsHost,
+	}}, nil
+}
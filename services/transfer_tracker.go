@@ -0,0 +1,113 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"log"
+	"sync"
+	"time"
+)
+
+const transferUpdateInterval = 2 * time.Second
+
+type TransferTracker struct {
+	hm         *HistoryManager
+	id         string
+	mu         sync.Mutex
+	matched    int
+	added      int
+	failed     int
+	started    time.Time
+	lastUpdate time.Time
+}
+
+func (hm *HistoryManager) StartTransfer(record TransferRecord) *TransferTracker {
+	if record.ID == "" {
+		record.ID = NewTransferID()
+	}
+	record.Status = TransferStatusRunning
+	record.CreatedAt = time.Now().Format(time.RFC3339)
+
+	t := &TransferTracker{hm: hm, id: record.ID, started: time.Now(), lastUpdate: time.Now()}
+	if hm == nil {
+		return t
+	}
+	if err := hm.AddRecord(record); err != nil {
+		log.Printf("failed to record transfer %s: %v", record.ID, err)
+	}
+	return t
+}
+
+func (hm *HistoryManager) ResumeTransfer(id string) *TransferTracker {
+	t := &TransferTracker{hm: hm, id: id, started: time.Now(), lastUpdate: time.Now()}
+	t.update(map[string]interface{}{
+		"status":       TransferStatusRunning,
+		"errorMessage": "",
+	})
+	return t
+}
+
+func (t *TransferTracker) ID() string {
+	return t.id
+}
+
+func (t *TransferTracker) TrackStatus(index int, status, errorMsg string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	switch status {
+	case "found":
+		t.matched++
+	case "added":
+		t.added++
+	case "error":
+		t.failed++
+	default:
+		return
+	}
+	if time.Since(t.lastUpdate) < transferUpdateInterval {
+		return
+	}
+	t.lastUpdate = time.Now()
+	t.update(map[string]interface{}{
+		"matchedTracks": t.matched,
+		"addedTracks":   t.added,
+		"failedTracks":  t.failed,
+	})
+}
+
+func (t *TransferTracker) Finish(stats *TransferStats, err error) {
+	updates := map[string]interface{}{
+		"status":      TransferStatusCompleted,
+		"completedAt": time.Now(),
+		"runDuration": time.Since(t.started),
+	}
+	if stats != nil {
+		updates["totalTracks"] = stats.Total
+		updates["matchedTracks"] = stats.Matched
+		updates["addedTracks"] = stats.Added
+		updates["failedTracks"] = stats.Failed
+		updates["libraryID"] = stats.LibraryID
+		updates["tracks"] = stats.Tracks
+	}
+	if err != nil {
+		updates["status"] = TransferStatusFailed
+		if errors.Is(err, context.Canceled) {
+			updates["status"] = TransferStatusCancelled
+		}
+		updates["errorMessage"] = err.Error()
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.update(updates)
+}
+
+func (t *TransferTracker) update(updates map[string]interface{}) {
+	if t.hm == nil {
+		return
+	}
+	if err := t.hm.UpdateRecord(t.id, updates); err != nil {
+		log.Printf("failed to update transfer %s: %v", t.id, err)
+	}
+}
@@ -0,0 +1,191 @@
+package services
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	VersionPreferSource   = "source"
+	VersionPreferAny      = "any"
+	VersionPreferExplicit = "explicit"
+	VersionPreferClean    = "clean"
+	VersionPreferOriginal = "original"
+	VersionPreferRemaster = "remaster"
+	VersionPreferStudio   = "studio"
+	VersionPreferLive     = "live"
+	VersionPreferHighest  = "highest"
+
+	defaultVersionTieMargin = 5
+)
+
+type VersionPolicy struct {
+	Explicit    string `json:"explicit"`
+	Edition     string `json:"edition"`
+	Performance string `json:"performance"`
+	Quality     string `json:"quality"`
+	TieMargin   int    `json:"tieMargin"`
+}
+
+func VersionPolicyFromConfig(cfg *Config) VersionPolicy {
+	p := VersionPolicy{
+		Explicit:    VersionPreferSource,
+		Edition:     VersionPreferSource,
+		Performance: VersionPreferSource,
+		Quality:     VersionPreferHighest,
+		TieMargin:   defaultVersionTieMargin,
+	}
+	if cfg == nil {
+		return p
+	}
+	if cfg.PreferExplicit != "" {
+		p.Explicit = cfg.PreferExplicit
+	}
+	if cfg.PreferEdition != "" {
+		p.Edition = cfg.PreferEdition
+	}
+	if cfg.PreferPerformance != "" {
+		p.Performance = cfg.PreferPerformance
+	}
+	if cfg.PreferQuality != "" {
+		p.Quality = cfg.PreferQuality
+	}
+	if cfg.VersionTieMargin != nil {
+		p.TieMargin = *cfg.VersionTieMargin
+	}
+	return p
+}
+
+func (p VersionPolicy) Validate() error {
+	check := func(name, value string, allowed ...string) error {
+		for _, a := range allowed {
+			if value == a {
+				return nil
+			}
+		}
+		return fmt.Errorf("invalid %s preference %q", name, value)
+	}
+	if err := check("explicit", p.Explicit, VersionPreferSource, VersionPreferAny, VersionPreferExplicit, VersionPreferClean); err != nil {
+		return err
+	}
+	if err := check("edition", p.Edition, VersionPreferSource, VersionPreferAny, VersionPreferOriginal, VersionPreferRemaster); err != nil {
+		return err
+	}
+	if err := check("performance", p.Performance, VersionPreferSource, VersionPreferAny, VersionPreferStudio, VersionPreferLive); err != nil {
+		return err
+	}
+	if err := check("quality", p.Quality, VersionPreferHighest, VersionPreferAny); err != nil {
+		return err
+	}
+	if p.TieMargin < 0 || p.TieMargin > 100 {
+		return fmt.Errorf("tie margin must be between 0 and 100")
+	}
+	return nil
+}
+
+func (p VersionPolicy) less(source TrackInfo, a, b DABTrack) bool {
+	if ra, rb := p.explicitRank(source, a), p.explicitRank(source, b); ra != rb {
+		return ra < rb
+	}
+	if ra, rb := p.editionRank(source, a), p.editionRank(source, b); ra != rb {
+		return ra < rb
+	}
+	if ra, rb := p.performanceRank(source, a), p.performanceRank(source, b); ra != rb {
+		return ra < rb
+	}
+	if p.Quality == VersionPreferHighest {
+		qa, qb := a.AudioQuality, b.AudioQuality
+		if qa.MaxBitDepth != qb.MaxBitDepth {
+			return qa.MaxBitDepth > qb.MaxBitDepth
+		}
+		if qa.MaxSamplingRate != qb.MaxSamplingRate {
+			return qa.MaxSamplingRate > qb.MaxSamplingRate
+		}
+	}
+	return false
+}
+
+func (p VersionPolicy) explicitRank(source TrackInfo, t DABTrack) int {
+	want := p.Explicit
+	if want == VersionPreferSource {
+		want = VersionPreferClean
+		if source.Explicit {
+			want = VersionPreferExplicit
+		}
+	}
+	switch want {
+	case VersionPreferExplicit:
+		return boolRank(t.ParentalWarning)
+	case VersionPreferClean:
+		return boolRank(!t.ParentalWarning)
+	}
+	return 0
+}
+
+func (p VersionPolicy) editionRank(source TrackInfo, t DABTrack) int {
+	want := p.Edition
+	if want == VersionPreferSource {
+		want = VersionPreferOriginal
+		if isRemaster(source.Title, source.AlbumTitle) {
+			want = VersionPreferRemaster
+		}
+	}
+	switch want {
+	case VersionPreferRemaster:
+		return boolRank(isRemaster(t.Title, t.AlbumTitle))
+	case VersionPreferOriginal:
+		return boolRank(!isRemaster(t.Title, t.AlbumTitle))
+	}
+	return 0
+}
+
+func (p VersionPolicy) performanceRank(source TrackInfo, t DABTrack) int {
+	want := p.Performance
+	if want == VersionPreferSource {
+		want = VersionPreferStudio
+		if isLiveVersion(source.Title, source.AlbumTitle) {
+			want = VersionPreferLive
+		}
+	}
+	switch want {
+	case VersionPreferLive:
+		return boolRank(isLiveVersion(t.Title, t.AlbumTitle))
+	case VersionPreferStudio:
+		return boolRank(!isLiveVersion(t.Title, t.AlbumTitle))
+	}
+	return 0
+}
+
+func boolRank(preferred bool) int {
+	if preferred {
+		return 0
+	}
+	return 1
+}
+
+func isRemaster(title, album string) bool {
+	for _, tok := range tokenize(title + " " + album) {
+		if strings.HasPrefix(tok, "remaster") {
+			return true
+		}
+	}
+	return false
+}
+
+func isLiveVersion(title, album string) bool {
+	for _, tok := range tokenize(title) {
+		if tok == "live" || tok == "unplugged" {
+			return true
+		}
+	}
+	albumTokens := tokenize(album)
+	for i, tok := range albumTokens {
+		if tok == "unplugged" {
+			return true
+		}
+		if tok == "live" && (i == 0 || i+1 < len(albumTokens) && (albumTokens[i+1] == "at" || albumTokens[i+1] == "in" || albumTokens[i+1] == "from")) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,280 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+)
+
+const watchIdleCheck = time.Minute
+
+type WatchedPlaylist struct {
+	ID            string `json:"id"`
+	Name          string `json:"name"`
+	SourceURL     string `json:"sourceURL"`
+	LibraryID     string `json:"libraryID"`
+	Schedule      string `json:"schedule"`
+	RemoveMissing bool   `json:"removeMissing"`
+	Enabled       bool   `json:"enabled"`
+	CreatedAt     string `json:"createdAt"`
+	LastRunAt     string `json:"lastRunAt,omitempty"`
+	NextRunAt     string `json:"nextRunAt,omitempty"`
+	LastStatus    string `json:"lastStatus,omitempty"`
+	LastError     string `json:"lastError,omitempty"`
+	LastAdded     int    `json:"lastAdded"`
+	LastRemoved   int    `json:"lastRemoved"`
+}
+
+func (w *WatchedPlaylist) nextRun(after time.Time) (time.Time, error) {
+	sched, err := ParseSchedule(w.Schedule)
+	if err != nil {
+		return time.Time{}, err
+	}
+	next := sched.Next(after)
+	if next.IsZero() {
+		return next, fmt.Errorf("schedule %q never fires", w.Schedule)
+	}
+	return next, nil
+}
+
+type WatchStore struct {
+	mu      sync.Mutex
+	path    string
+	watches map[string]WatchedPlaylist
+}
+
+func NewWatchStore() (*WatchStore, error) {
+	dir, err := GetConfigDir()
+	if err != nil {
+		return nil, err
+	}
+	_ = os.MkdirAll(dir, 0755)
+
+	ws := &WatchStore{
+		path:    filepath.Join(dir, "watched_playlists.json"),
+		watches: make(map[string]WatchedPlaylist),
+	}
+	data, err := os.ReadFile(ws.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return ws, nil
+		}
+		return nil, err
+	}
+
+	var watches []WatchedPlaylist
+	if err := json.Unmarshal(data, &watches); err != nil {
+		return nil, err
+	}
+	for _, w := range watches {
+		ws.watches[w.ID] = w
+	}
+	return ws, nil
+}
+
+func (ws *WatchStore) List() []WatchedPlaylist {
+	if ws == nil {
+		return []WatchedPlaylist{}
+	}
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+	return ws.listLocked()
+}
+
+func (ws *WatchStore) listLocked() []WatchedPlaylist {
+	watches := make([]WatchedPlaylist, 0, len(ws.watches))
+	for _, w := range ws.watches {
+		watches = append(watches, w)
+	}
+	sort.Slice(watches, func(i, j int) bool {
+		return watches[i].CreatedAt < watches[j].CreatedAt
+	})
+	return watches
+}
+
+func (ws *WatchStore) Get(id string) (WatchedPlaylist, bool) {
+	if ws == nil {
+		return WatchedPlaylist{}, false
+	}
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+	w, ok := ws.watches[id]
+	return w, ok
+}
+
+func (ws *WatchStore) Save(w WatchedPlaylist) (WatchedPlaylist, error) {
+	if ws == nil {
+		return w, fmt.Errorf("watch store unavailable")
+	}
+	if w.SourceURL == "" {
+		return w, fmt.Errorf("source URL is required")
+	}
+	if w.LibraryID == "" {
+		return w, fmt.Errorf("library ID is required")
+	}
+	next, err := w.nextRun(time.Now())
+	if err != nil {
+		return w, err
+	}
+
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+
+	if w.ID == "" {
+		w.ID = fmt.Sprintf("watch_%d", time.Now().UnixMilli())
+		w.CreatedAt = time.Now().Format(time.RFC3339)
+	} else if prev, ok := ws.watches[w.ID]; ok {
+		w.CreatedAt = prev.CreatedAt
+		w.LastRunAt = prev.LastRunAt
+		w.LastStatus = prev.LastStatus
+		w.LastError = prev.LastError
+		w.LastAdded = prev.LastAdded
+		w.LastRemoved = prev.LastRemoved
+	}
+	w.NextRunAt = next.Format(time.RFC3339)
+	ws.watches[w.ID] = w
+	return w, ws.saveLocked()
+}
+
+func (ws *WatchStore) Delete(id string) error {
+	if ws == nil {
+		return fmt.Errorf("watch store unavailable")
+	}
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+	if _, ok := ws.watches[id]; !ok {
+		return fmt.Errorf("watched playlist %s not found", id)
+	}
+	delete(ws.watches, id)
+	return ws.saveLocked()
+}
+
+func (ws *WatchStore) recordRun(id string, ranAt time.Time, result *SyncResult, runErr error) {
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+	w, ok := ws.watches[id]
+	if !ok {
+		return
+	}
+	w.LastRunAt = ranAt.Format(time.RFC3339)
+	w.LastStatus = "completed"
+	w.LastError = ""
+	w.LastAdded = 0
+	w.LastRemoved = 0
+	if result != nil {
+		w.LastAdded = result.Added
+		w.LastRemoved = result.Removed
+	}
+	if runErr != nil {
+		w.LastStatus = "failed"
+		w.LastError = runErr.Error()
+	}
+	if next, err := w.nextRun(time.Now()); err == nil {
+		w.NextRunAt = next.Format(time.RFC3339)
+	}
+	ws.watches[id] = w
+	if err := ws.saveLocked(); err != nil {
+		log.Printf("failed to save watched playlists: %v", err)
+	}
+}
+
+func (ws *WatchStore) saveLocked() error {
+	data, err := json.MarshalIndent(ws.listLocked(), "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(ws.path, data, 0644)
+}
+
+type WatchRunner func(ctx context.Context, w WatchedPlaylist) (*SyncResult, error)
+
+type WatchScheduler struct {
+	store   *WatchStore
+	run     WatchRunner
+	wake    chan struct{}
+	mu      sync.Mutex
+	running map[string]bool
+}
+
+func NewWatchScheduler(store *WatchStore, run WatchRunner) *WatchScheduler {
+	return &WatchScheduler{
+		store:   store,
+		run:     run,
+		wake:    make(chan struct{}, 1),
+		running: make(map[string]bool),
+	}
+}
+
+func (s *WatchScheduler) Wake() {
+	select {
+	case s.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (s *WatchScheduler) Run(ctx context.Context) {
+	for {
+		wait := s.runDue(ctx, time.Now())
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-s.wake:
+			timer.Stop()
+		case <-timer.C:
+		}
+	}
+}
+
+func (s *WatchScheduler) runDue(ctx context.Context, now time.Time) time.Duration {
+	wait := watchIdleCheck
+	for _, w := range s.store.List() {
+		if ctx.Err() != nil {
+			return wait
+		}
+		if !w.Enabled {
+			continue
+		}
+		next, err := time.Parse(time.RFC3339, w.NextRunAt)
+		if err != nil || !next.After(now) {
+			s.RunNow(ctx, w.ID)
+			continue
+		}
+		if d := next.Sub(now); d < wait {
+			wait = d
+		}
+	}
+	return wait
+}
+
+func (s *WatchScheduler) RunNow(ctx context.Context, id string) (*SyncResult, error) {
+	w, ok := s.store.Get(id)
+	if !ok {
+		return nil, fmt.Errorf("watched playlist %s not found", id)
+	}
+
+	s.mu.Lock()
+	if s.running[id] {
+		s.mu.Unlock()
+		return nil, fmt.Errorf("watched playlist %s is already syncing", w.Name)
+	}
+	s.running[id] = true
+	s.mu.Unlock()
+	defer func() {
+		s.mu.Lock()
+		delete(s.running, id)
+		s.mu.Unlock()
+	}()
+
+	started := time.Now()
+	result, err := s.run(ctx, w)
+	s.store.recordRun(id, started, result, err)
+	return result, err
+}